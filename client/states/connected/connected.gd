@@ -26,8 +26,23 @@ func _on_ws_packet_received(packet: packets.Packet) -> void:
 		_log.error(deny_response_message.get_reason())
 	elif packet.has_ok_response():
 		_action_on_ok_received.call()
+	elif packet.has_room_list():
+		_handle_room_list_msg(sender_id, packet.get_room_list())
 		
 
+func _handle_room_list_msg(sender_id: int, room_list_msg: packets.RoomListMessage) -> void:
+	for room_msg in room_list_msg.get_rooms():
+		if room_msg.get_player_count() < room_msg.get_max_players():
+			var packet := packets.Packet.new()
+			var join_room_request_msg := packet.new_join_room_request()
+			join_room_request_msg.set_room_id(room_msg.get_id())
+			WS.send(packet)
+			_action_on_ok_received = func() -> void: GameManager.set_state(GameManager.State.INGAME)
+			return
+
+	_log.error("All rooms are full, try again later")
+
+
 func _on_ws_connection_closed() -> void:
 	pass
 	
@@ -42,7 +57,8 @@ func _on_login_form_submitted(username: String, password: String) -> void:
 	login_request_msg.set_username(username)
 	login_request_msg.set_password(password)
 	WS.send(packet)
-	_action_on_ok_received = func() -> void: GameManager.set_state(GameManager.State.INGAME)
+	# The server follows up with the list of rooms to join
+	_action_on_ok_received = func() -> void: _log.success("Logged in")
 
 
 func _on_register_form_submitted(username: String, password: String, confirm_password: String, color: Color) -> void:
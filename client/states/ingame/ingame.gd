@@ -43,6 +43,12 @@ func _on_ws_packet_received(packet: packets.Packet) -> void:
 		_handle_spores_batch_msg(sender_id, packet.get_spores_batch())
 	elif packet.has_spore_consumed():
 		_handle_spore_consumed_msg(sender_id, packet.get_spore_consumed())
+	elif packet.has_player_consumed():
+		_handle_player_consumed_msg(sender_id, packet.get_player_consumed())
+	elif packet.has_world_update():
+		_handle_world_update_msg(sender_id, packet.get_world_update())
+	elif packet.has_out_of_view():
+		_handle_out_of_view_msg(sender_id, packet.get_out_of_view())
 	elif packet.has_disconnect():
 		_handle_disconnect_msg(sender_id, packet.get_disconnect())
 	elif packet.has_game_bounds():
@@ -82,9 +88,6 @@ func _add_actor(actor_id: int, actor_name: String, x: float, y: float, radius: f
 	_set_actor_mass(actor, _rad_to_mass(radius))
 	_players[actor_id] = actor
 
-	if is_player:
-		actor.area_entered.connect(_on_player_area_entered)
-
 
 func _update_actor(actor_id: int, x: float, y: float, direction: float, speed: float, radius: float, is_player: bool) -> void:
 	var actor := _players[actor_id]
@@ -116,6 +119,8 @@ func _handle_spore_msg(sender_id: int, spore_msg: packets.SporeMessage) -> void:
 		var spore := Spore.instantiate(spore_id, x, y, radius, underneath_player)
 		world.add_child(spore)
 		_spores[spore_id] = spore
+	else:
+		_spores[spore_id].position = Vector2(x, y)
 
 
 func _handle_spore_consumed_msg(sender_id: int, spore_consumed_msg: packets.SporeConsumedMessage) -> void:
@@ -130,6 +135,29 @@ func _handle_spore_consumed_msg(sender_id: int, spore_consumed_msg: packets.Spor
 			_set_actor_mass(actor, actor_mass + spore_mass)
 			_remove_spore(spore)
 
+
+func _handle_player_consumed_msg(sender_id: int, player_consumed_msg: packets.PlayerConsumedMessage) -> void:
+	var player_id := player_consumed_msg.get_player_id()
+	if player_id in _players:
+		_remove_actor(_players[player_id])
+
+
+func _handle_world_update_msg(sender_id: int, world_update_msg: packets.WorldUpdateMessage) -> void:
+	for player_msg in world_update_msg.get_players():
+		_handle_player_msg(sender_id, player_msg)
+	for spore_msg in world_update_msg.get_spores():
+		_handle_spore_msg(sender_id, spore_msg)
+
+
+func _handle_out_of_view_msg(sender_id: int, out_of_view_msg: packets.OutOfViewMessage) -> void:
+	for player_id in out_of_view_msg.get_player_ids():
+		if player_id in _players:
+			_remove_actor(_players[player_id])
+	for spore_id in out_of_view_msg.get_spore_ids():
+		if spore_id in _spores:
+			_remove_spore(_spores[spore_id])
+
+
 func _rad_to_mass(radius: float) -> float:
 	return radius * radius * PI
 
@@ -139,22 +167,6 @@ func _set_actor_mass(actor: Actor, new_mass: float) -> void:
 	hiscores.set_hiscore(actor.actor_name, roundi(new_mass))
 
 
-func _consume_spore(spore: Spore) -> void:
-	if spore.underneath_player:
-		return
-
-	var player := _players[GameManager.client_id]
-	var player_mass := _rad_to_mass(player.radius)
-	var spore_mass := _rad_to_mass(spore.radius)
-	_set_actor_mass(player, player_mass + spore_mass)
-
-	var packet := packets.Packet.new()
-	var spore_consumed_msg := packet.new_spore_consumed()
-	spore_consumed_msg.set_spore_id(spore.spore_id)
-	WS.send(packet)
-	_remove_spore(spore)
-
-
 func _remove_spore(spore: Spore) -> void:
 	_spores.erase(spore.spore_id)
 	spore.queue_free()
@@ -200,13 +212,6 @@ func _on_line_edit_text_entered(text: String) -> void:
 	_line_edit.text = ""
 
 
-func _on_player_area_entered(area: Area2D) -> void:
-	if area is Spore:
-		_consume_spore(area as Spore)
-	elif area is Actor:
-		_collide_actor(area as Actor)
-
-
 func _on_logout_button_pressed() -> void:
 	var packet := packets.Packet.new()
 	var disconnect_msg := packet.new_disconnect()
@@ -230,28 +235,6 @@ func _on_send_button_pressed() -> void:
 	_on_line_edit_text_entered(_line_edit.text)
 
 
-func _collide_actor(actor: Actor) -> void:
-	var player := _players[GameManager.client_id]
-	var player_mass := _rad_to_mass(player.radius)
-	var actor_mass := _rad_to_mass(actor.radius)
-
-	if player_mass > actor_mass * 1.5:
-		_consume_actor(actor)
-
-
-func _consume_actor(actor: Actor) -> void:
-	var player := _players[GameManager.client_id]
-	var player_mass := _rad_to_mass(player.radius)
-	var actor_mass := _rad_to_mass(actor.radius)
-	_set_actor_mass(player, player_mass + actor_mass)
-
-	var packet := packets.Packet.new()
-	var player_consumed_msg := packet.new_player_consumed()
-	player_consumed_msg.set_player_id(actor.actor_id)
-	WS.send(packet)
-	_remove_actor(actor)
-
-
 func _remove_actor(actor: Actor) -> void:
 	_players.erase(actor.actor_id)
 	actor.queue_free()
@@ -7,25 +7,47 @@ import (
 	"net/http"
 	"os"
 	"server/internal/server"
+	"server/internal/server/auth"
 	"server/internal/server/clients"
 	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type config struct {
-	DatabaseURL string
-	Port        int
+	DatabaseURL       string
+	Port              int
+	SessionSecret     string
+	MinPasswordLength int
+	TrustProxy        bool
 }
 
 var (
-	defaultConfig = &config{Port: 8080}
+	defaultConfig = &config{Port: 8080, MinPasswordLength: auth.DefaultPasswordPolicy.MinLength}
 	configPath    = flag.String("config", ".env", "Path to the config file")
 )
 
 func loadConfig() *config {
 	cfg := defaultConfig
 	cfg.DatabaseURL = os.Getenv("DATABASE_URL")
+	cfg.SessionSecret = os.Getenv("SESSION_SECRET")
+
+	if minLength := os.Getenv("MIN_PASSWORD_LENGTH"); minLength != "" {
+		if length, err := strconv.Atoi(minLength); err == nil && length > 0 {
+			cfg.MinPasswordLength = length
+		} else {
+			log.Printf("Error parsing MIN_PASSWORD_LENGTH, using %d", cfg.MinPasswordLength)
+		}
+	}
+
+	if trustProxy := os.Getenv("TRUST_PROXY"); trustProxy != "" {
+		if trust, err := strconv.ParseBool(trustProxy); err == nil {
+			cfg.TrustProxy = trust
+		} else {
+			log.Printf("Error parsing TRUST_PROXY, using %t", cfg.TrustProxy)
+		}
+	}
 
 	port, err := strconv.Atoi(os.Getenv("PORT"))
 	if err != nil {
@@ -51,13 +73,23 @@ func main() {
 		log.Fatal("DATABASE_URL environment variable is required")
 	}
 
-	hub := server.NewHub(cfg.DatabaseURL)
+	sessionKey := []byte(cfg.SessionSecret)
+	if len(sessionKey) == 0 {
+		log.Println("SESSION_SECRET is not set, so remembered logins won't survive a restart")
+		sessionKey = auth.RandomKey()
+	}
+
+	auth.DefaultPasswordPolicy.MinLength = cfg.MinPasswordLength
+	clients.TrustProxy = cfg.TrustProxy
+
+	hub := server.NewHub(cfg.DatabaseURL, sessionKey)
 
 	http.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
 		hub.Serve(clients.NewWebSocketClient, w, r)
 	})
 
 	go hub.Run()
+	go hub.RunBots(clients.NewBotClient, 5*time.Second)
 	addr := fmt.Sprintf(":%d", cfg.Port)
 
 	log.Printf("Starting server on %s", addr)
@@ -0,0 +1,114 @@
+package auth
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// What it takes for a password to be accepted
+type PasswordPolicy struct {
+	MinLength int
+
+	// bcrypt only looks at the first 72 bytes, so anything longer gives a false sense of security
+	MaxLength int
+
+	// Whether to turn away passwords from the list of ones attackers try first
+	RejectCommon bool
+
+	// Whether to turn away passwords which are the username give or take a few characters
+	RejectUsername bool
+}
+
+// The policy new passwords are checked against. The server's config may change it at startup.
+var DefaultPasswordPolicy = PasswordPolicy{
+	MinLength:      8,
+	MaxLength:      72,
+	RejectCommon:   true,
+	RejectUsername: true,
+}
+
+// A password this many edits or fewer away from the username is too close to it
+const usernameSimilarity = 2
+
+// Usernames shorter than this turn up inside plenty of good passwords by chance, so passwords
+// aren't turned away just for containing them
+const minContainedUsername = 3
+
+//go:embed common_passwords.txt
+var commonPasswordsTxt string
+
+var commonPasswords = parseCommonPasswords(commonPasswordsTxt)
+
+func parseCommonPasswords(list string) map[string]struct{} {
+	passwords := make(map[string]struct{})
+	for line := range strings.Lines(list) {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			passwords[strings.ToLower(line)] = struct{}{}
+		}
+	}
+	return passwords
+}
+
+// Returns why the password isn't good enough for the user, or nil if it is
+func (p PasswordPolicy) Check(password, username string) error {
+	if utf8.RuneCountInString(password) < p.MinLength {
+		return fmt.Errorf("must be at least %d characters", p.MinLength)
+	}
+	if p.MaxLength > 0 && len(password) > p.MaxLength {
+		return fmt.Errorf("must be at most %d bytes", p.MaxLength)
+	}
+
+	lowered := strings.ToLower(password)
+	if _, common := commonPasswords[lowered]; p.RejectCommon && common {
+		return errors.New("too common - it is one of the first passwords attackers try")
+	}
+	if p.RejectUsername && similar(lowered, strings.ToLower(username)) {
+		return errors.New("too similar to your username")
+	}
+	return nil
+}
+
+// Whether the password is the username spelled backwards, padded out or slightly changed
+func similar(password, username string) bool {
+	if username == "" {
+		return false
+	}
+	if editDistance(password, username) <= usernameSimilarity {
+		return true
+	}
+	if utf8.RuneCountInString(username) < minContainedUsername {
+		return false
+	}
+
+	reversed := []rune(username)
+	slices.Reverse(reversed)
+	return strings.Contains(password, username) || strings.Contains(password, string(reversed))
+}
+
+// The number of single character insertions, deletions and substitutions between a and b
+func editDistance(a, b string) int {
+	runesA, runesB := []rune(a), []rune(b)
+	previous := make([]int, len(runesB)+1)
+	current := make([]int, len(runesB)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i, runeA := range runesA {
+		current[0] = i + 1
+		for j, runeB := range runesB {
+			substitution := previous[j]
+			if runeA != runeB {
+				substitution++
+			}
+			current[j+1] = min(previous[j+1]+1, current[j]+1, substitution)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(runesB)]
+}
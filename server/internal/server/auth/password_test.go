@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestPasswordPolicy tests which passwords are turned away
+func TestPasswordPolicy(t *testing.T) {
+	policy := DefaultPasswordPolicy
+
+	t.Run("Good passwords are accepted", func(t *testing.T) {
+		for _, password := range []string{"correct horse battery", "Tr0ub4dor&3", strings.Repeat("x", policy.MaxLength)} {
+			if err := policy.Check(password, "alice"); err != nil {
+				t.Errorf("Expected %q to be accepted, got %v", password, err)
+			}
+		}
+	})
+
+	t.Run("Passwords must be the right length", func(t *testing.T) {
+		for _, password := range []string{"", "a", "short1!", strings.Repeat("x", policy.MaxLength+1)} {
+			if err := policy.Check(password, "alice"); err == nil {
+				t.Errorf("Expected %q to be rejected for its length", password)
+			}
+		}
+	})
+
+	t.Run("Common passwords are rejected whatever the case", func(t *testing.T) {
+		for _, password := range []string{"password", "Password1", "QWERTY123", "iloveyou"} {
+			if err := policy.Check(password, "alice"); err == nil {
+				t.Errorf("Expected %q to be rejected as too common", password)
+			}
+		}
+	})
+
+	t.Run("Passwords close to the username are rejected", func(t *testing.T) {
+		for _, password := range []string{"Blobmaster", "blobmaster99", "retsambolb", "blobmastr", "xBlobMaster"} {
+			if err := policy.Check(password, "BlobMaster"); err == nil {
+				t.Errorf("Expected %q to be rejected as too like the username", password)
+			}
+		}
+	})
+
+	t.Run("Short usernames don't rule out every password containing them", func(t *testing.T) {
+		for _, username := range []string{"a", "jo", "é"} {
+			if err := policy.Check("correct horse battery", username); err != nil {
+				t.Errorf("Expected a password containing %q to be accepted, got %v", username, err)
+			}
+		}
+	})
+
+	t.Run("Lengths are counted in characters", func(t *testing.T) {
+		// Eight bytes, but only four characters
+		if err := policy.Check("éééé", "alice"); err == nil {
+			t.Error("Expected a four character password to be rejected as too short")
+		}
+	})
+
+	t.Run("Checks can be switched off", func(t *testing.T) {
+		relaxed := PasswordPolicy{MinLength: 1}
+		for _, password := range []string{"a", "password", "blobmaster"} {
+			if err := relaxed.Check(password, "blobmaster"); err != nil {
+				t.Errorf("Expected %q to be accepted under a relaxed policy, got %v", password, err)
+			}
+		}
+	})
+}
+
+// TestEditDistance tests counting the edits between two strings
+func TestEditDistance(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		distance int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"blob", "blob", 0},
+		{"blob", "blb", 1},
+		{"héllo", "hello", 1},
+	}
+	for _, c := range cases {
+		if distance := editDistance(c.a, c.b); distance != c.distance {
+			t.Errorf("Expected %d edits between %q and %q, got %d", c.distance, c.a, c.b, distance)
+		}
+	}
+}
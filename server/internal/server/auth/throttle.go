@@ -0,0 +1,275 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"server/internal/server/db"
+	"time"
+)
+
+// How quickly failed logins get something locked out
+type ThrottlePolicy struct {
+	// Failures allowed before the first lockout
+	FreeAttempts int
+
+	// The first lockout lasts this long, doubling with every failure after it up to MaxLockout
+	BaseLockout time.Duration
+	MaxLockout  time.Duration
+
+	// Failures are forgotten after this long without another
+	ForgetAfter time.Duration
+}
+
+var (
+	// Accounts are locked quickly, since nobody forgets their password that many times in a row
+	AccountPolicy = ThrottlePolicy{
+		FreeAttempts: 5,
+		BaseLockout:  30 * time.Second,
+		MaxLockout:   time.Hour,
+		ForgetAfter:  24 * time.Hour,
+	}
+
+	// Addresses get more leeway, since a whole household or school can share one
+	AddressPolicy = ThrottlePolicy{
+		FreeAttempts: 20,
+		BaseLockout:  30 * time.Second,
+		MaxLockout:   time.Hour,
+		ForgetAfter:  24 * time.Hour,
+	}
+)
+
+// Recent failed logins for an account or address
+type Attempts struct {
+	Failures      int
+	LastFailureAt time.Time
+	LockedUntil   time.Time
+}
+
+// How much longer logins are locked out for, or 0 if they aren't
+func (a Attempts) LockedFor(now time.Time) time.Duration {
+	return max(a.LockedUntil.Sub(now), 0)
+}
+
+// How long the given number of failures in a row locks logins out for, or 0 if they don't yet
+func (p ThrottlePolicy) Lockout(failures int) time.Duration {
+	excess := failures - p.FreeAttempts
+	if excess <= 0 {
+		return 0
+	}
+
+	lockout := p.BaseLockout
+	for range excess - 1 {
+		if lockout >= p.MaxLockout {
+			break
+		}
+		lockout *= 2
+	}
+	return min(lockout, p.MaxLockout)
+}
+
+// Where lockouts of accounts or addresses are kept, so restarting the server doesn't let anybody
+// off. Keys are usernames or addresses.
+type AttemptStore interface {
+	// Returns zero Attempts for keys with no recent failures
+	LoadAttempts(ctx context.Context, key string) (Attempts, error)
+
+	// Counts one more failure in a single step, so failures racing each other are all counted.
+	// Failures from before forgetBefore are forgotten first. Returns the attempts as they now stand.
+	AddFailure(ctx context.Context, key string, now, forgetBefore time.Time) (Attempts, error)
+
+	// Locks the key until the given time, unless it is already locked for longer
+	LockUntil(ctx context.Context, key string, until time.Time) error
+
+	ClearAttempts(ctx context.Context, key string) error
+
+	// Forgets keys whose last failure was before forgetBefore and which aren't locked at now
+	DeleteExpiredAttempts(ctx context.Context, forgetBefore, now time.Time) error
+}
+
+// Throttles password logins by account and by the address they come from, so nobody can keep
+// guessing passwords or tie the server up hashing them
+type LoginGuard struct {
+	accounts  AttemptStore
+	addresses AttemptStore
+
+	// Stands in for time.Now, so tests can move the clock along
+	Now func() time.Time
+}
+
+func NewLoginGuard(queries *db.Queries) *LoginGuard {
+	return newLoginGuard(dbAttemptStore{queries}, dbAddressAttemptStore{queries})
+}
+
+func newLoginGuard(accounts, addresses AttemptStore) *LoginGuard {
+	return &LoginGuard{
+		accounts:  accounts,
+		addresses: addresses,
+		Now:       time.Now,
+	}
+}
+
+// How much longer logins to the account or from the address are locked out for, or 0 if they
+// aren't. Addresses may be empty for clients without one.
+func (g *LoginGuard) LockedFor(ctx context.Context, username, address string) (time.Duration, error) {
+	now := g.Now()
+	attempts, err := g.accounts.LoadAttempts(ctx, username)
+	if err != nil {
+		return 0, err
+	}
+
+	lockedFor := attempts.LockedFor(now)
+	if address != "" {
+		attempts, err := g.addresses.LoadAttempts(ctx, address)
+		if err != nil {
+			return 0, err
+		}
+		lockedFor = max(lockedFor, attempts.LockedFor(now))
+	}
+	return lockedFor, nil
+}
+
+// Records a wrong password for the account from the address
+func (g *LoginGuard) Fail(ctx context.Context, username, address string) error {
+	now := g.Now()
+	if address != "" {
+		if err := fail(ctx, g.addresses, address, AddressPolicy, now); err != nil {
+			return err
+		}
+	}
+	return fail(ctx, g.accounts, username, AccountPolicy, now)
+}
+
+// Counts a failure against the key, locking it out once the policy's free attempts are used up
+func fail(ctx context.Context, store AttemptStore, key string, policy ThrottlePolicy, now time.Time) error {
+	attempts, err := store.AddFailure(ctx, key, now, now.Add(-policy.ForgetAfter))
+	if err != nil {
+		return err
+	}
+	if lockout := policy.Lockout(attempts.Failures); lockout > 0 {
+		return store.LockUntil(ctx, key, now.Add(lockout))
+	}
+	return nil
+}
+
+// Forgets the account's failures once its password has been entered correctly. The address's
+// failures stand, or an attacker could clear them by logging in to an account of their own.
+func (g *LoginGuard) Succeed(ctx context.Context, username string) error {
+	return g.accounts.ClearAttempts(ctx, username)
+}
+
+// Forgets failures which no longer count towards a lockout. Failed logins for usernames nobody has
+// would otherwise be kept forever.
+func (g *LoginGuard) Sweep(ctx context.Context) error {
+	now := g.Now()
+	if err := g.accounts.DeleteExpiredAttempts(ctx, now.Add(-AccountPolicy.ForgetAfter), now); err != nil {
+		return err
+	}
+	return g.addresses.DeleteExpiredAttempts(ctx, now.Add(-AddressPolicy.ForgetAfter), now)
+}
+
+type dbAttemptStore struct {
+	queries *db.Queries
+}
+
+func (s dbAttemptStore) LoadAttempts(ctx context.Context, username string) (Attempts, error) {
+	row, err := s.queries.GetLoginAttempts(ctx, username)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Attempts{}, nil
+	}
+	if err != nil {
+		return Attempts{}, err
+	}
+	return Attempts{
+		Failures:      int(row.Failures),
+		LastFailureAt: row.LastFailureAt,
+		LockedUntil:   row.LockedUntil,
+	}, nil
+}
+
+func (s dbAttemptStore) AddFailure(ctx context.Context, username string, now, forgetBefore time.Time) (Attempts, error) {
+	row, err := s.queries.AddLoginFailure(ctx, db.AddLoginFailureParams{
+		Username:      username,
+		LastFailureAt: now,
+		ForgetBefore:  forgetBefore,
+	})
+	if err != nil {
+		return Attempts{}, err
+	}
+	return Attempts{
+		Failures:      int(row.Failures),
+		LastFailureAt: row.LastFailureAt,
+		LockedUntil:   row.LockedUntil,
+	}, nil
+}
+
+func (s dbAttemptStore) LockUntil(ctx context.Context, username string, until time.Time) error {
+	return s.queries.LockLoginAttempts(ctx, db.LockLoginAttemptsParams{
+		Username:    username,
+		LockedUntil: until,
+	})
+}
+
+func (s dbAttemptStore) ClearAttempts(ctx context.Context, username string) error {
+	return s.queries.DeleteLoginAttempts(ctx, username)
+}
+
+func (s dbAttemptStore) DeleteExpiredAttempts(ctx context.Context, forgetBefore, now time.Time) error {
+	return s.queries.DeleteExpiredLoginAttempts(ctx, db.DeleteExpiredLoginAttemptsParams{
+		ForgetBefore: forgetBefore,
+		Now:          now,
+	})
+}
+
+type dbAddressAttemptStore struct {
+	queries *db.Queries
+}
+
+func (s dbAddressAttemptStore) LoadAttempts(ctx context.Context, address string) (Attempts, error) {
+	row, err := s.queries.GetAddressLoginAttempts(ctx, address)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Attempts{}, nil
+	}
+	if err != nil {
+		return Attempts{}, err
+	}
+	return Attempts{
+		Failures:      int(row.Failures),
+		LastFailureAt: row.LastFailureAt,
+		LockedUntil:   row.LockedUntil,
+	}, nil
+}
+
+func (s dbAddressAttemptStore) AddFailure(ctx context.Context, address string, now, forgetBefore time.Time) (Attempts, error) {
+	row, err := s.queries.AddAddressLoginFailure(ctx, db.AddAddressLoginFailureParams{
+		Address:       address,
+		LastFailureAt: now,
+		ForgetBefore:  forgetBefore,
+	})
+	if err != nil {
+		return Attempts{}, err
+	}
+	return Attempts{
+		Failures:      int(row.Failures),
+		LastFailureAt: row.LastFailureAt,
+		LockedUntil:   row.LockedUntil,
+	}, nil
+}
+
+func (s dbAddressAttemptStore) LockUntil(ctx context.Context, address string, until time.Time) error {
+	return s.queries.LockAddressLoginAttempts(ctx, db.LockAddressLoginAttemptsParams{
+		Address:     address,
+		LockedUntil: until,
+	})
+}
+
+func (s dbAddressAttemptStore) ClearAttempts(ctx context.Context, address string) error {
+	return s.queries.DeleteAddressLoginAttempts(ctx, address)
+}
+
+func (s dbAddressAttemptStore) DeleteExpiredAttempts(ctx context.Context, forgetBefore, now time.Time) error {
+	return s.queries.DeleteExpiredAddressLoginAttempts(ctx, db.DeleteExpiredAddressLoginAttemptsParams{
+		ForgetBefore: forgetBefore,
+		Now:          now,
+	})
+}
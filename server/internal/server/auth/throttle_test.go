@@ -0,0 +1,247 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Keeps lockouts in memory in place of the database
+type memoryAttemptStore struct {
+	attempts map[string]Attempts
+	mux      sync.Mutex
+}
+
+func (s *memoryAttemptStore) LoadAttempts(_ context.Context, username string) (Attempts, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.attempts[username], nil
+}
+
+func (s *memoryAttemptStore) AddFailure(_ context.Context, username string, now, forgetBefore time.Time) (Attempts, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	attempts := s.attempts[username]
+	if !attempts.LastFailureAt.After(forgetBefore) {
+		attempts.Failures = 0
+	}
+	attempts.Failures++
+	attempts.LastFailureAt = now
+	s.attempts[username] = attempts
+	return attempts, nil
+}
+
+func (s *memoryAttemptStore) LockUntil(_ context.Context, username string, until time.Time) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	attempts := s.attempts[username]
+	if until.After(attempts.LockedUntil) {
+		attempts.LockedUntil = until
+		s.attempts[username] = attempts
+	}
+	return nil
+}
+
+func (s *memoryAttemptStore) ClearAttempts(_ context.Context, username string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	delete(s.attempts, username)
+	return nil
+}
+
+func (s *memoryAttemptStore) DeleteExpiredAttempts(_ context.Context, forgetBefore, now time.Time) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	for key, attempts := range s.attempts {
+		if !attempts.LastFailureAt.After(forgetBefore) && !attempts.LockedUntil.After(now) {
+			delete(s.attempts, key)
+		}
+	}
+	return nil
+}
+
+func newMemoryAttemptStore() *memoryAttemptStore {
+	return &memoryAttemptStore{attempts: make(map[string]Attempts)}
+}
+
+// testGuard returns a guard whose clock only moves when the test moves it
+func testGuard() (*LoginGuard, *memoryAttemptStore, *time.Time) {
+	store := newMemoryAttemptStore()
+	guard := newLoginGuard(store, newMemoryAttemptStore())
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	guard.Now = func() time.Time { return now }
+	return guard, store, &now
+}
+
+func lockedFor(t *testing.T, guard *LoginGuard, username, address string) time.Duration {
+	t.Helper()
+	lockedFor, err := guard.LockedFor(context.Background(), username, address)
+	if err != nil {
+		t.Fatalf("Failed to check lockout: %v", err)
+	}
+	return lockedFor
+}
+
+// TestLoginGuard tests locking out accounts and addresses after failed logins
+func TestLoginGuard(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Accounts are locked after the free attempts", func(t *testing.T) {
+		guard, _, _ := testGuard()
+		for range AccountPolicy.FreeAttempts {
+			guard.Fail(ctx, "alice", "1.2.3.4")
+		}
+		if locked := lockedFor(t, guard, "alice", "1.2.3.4"); locked != 0 {
+			t.Fatalf("Expected the free attempts not to lock the account, got %v", locked)
+		}
+
+		guard.Fail(ctx, "alice", "1.2.3.4")
+
+		if locked := lockedFor(t, guard, "alice", "5.6.7.8"); locked != AccountPolicy.BaseLockout {
+			t.Errorf("Expected the account to be locked for %v from anywhere, got %v", AccountPolicy.BaseLockout, locked)
+		}
+		if locked := lockedFor(t, guard, "bob", "5.6.7.8"); locked != 0 {
+			t.Errorf("Expected other accounts to be unaffected, got %v", locked)
+		}
+	})
+
+	t.Run("The lockout window runs out", func(t *testing.T) {
+		guard, _, now := testGuard()
+		for range AccountPolicy.FreeAttempts + 1 {
+			guard.Fail(ctx, "alice", "")
+		}
+
+		*now = now.Add(AccountPolicy.BaseLockout - time.Second)
+		if locked := lockedFor(t, guard, "alice", ""); locked != time.Second {
+			t.Errorf("Expected a second of the lockout to be left, got %v", locked)
+		}
+
+		*now = now.Add(time.Second)
+		if locked := lockedFor(t, guard, "alice", ""); locked != 0 {
+			t.Errorf("Expected the lockout to be over, got %v", locked)
+		}
+	})
+
+	t.Run("Lockouts double with every failure up to the limit", func(t *testing.T) {
+		guard, store, now := testGuard()
+		for range AccountPolicy.FreeAttempts {
+			guard.Fail(ctx, "alice", "")
+		}
+
+		expected := AccountPolicy.BaseLockout
+		for range 10 {
+			guard.Fail(ctx, "alice", "")
+			if locked := lockedFor(t, guard, "alice", ""); locked != expected {
+				t.Fatalf("Expected a %v lockout after %d failures, got %v", expected, store.attempts["alice"].Failures, locked)
+			}
+			*now = now.Add(expected)
+			expected = min(expected*2, AccountPolicy.MaxLockout)
+		}
+	})
+
+	t.Run("Failures are forgotten after a while", func(t *testing.T) {
+		guard, store, now := testGuard()
+		for range AccountPolicy.FreeAttempts {
+			guard.Fail(ctx, "alice", "")
+		}
+
+		*now = now.Add(AccountPolicy.ForgetAfter)
+		guard.Fail(ctx, "alice", "")
+
+		if failures := store.attempts["alice"].Failures; failures != 1 {
+			t.Errorf("Expected old failures to be forgotten, got %d", failures)
+		}
+		if locked := lockedFor(t, guard, "alice", ""); locked != 0 {
+			t.Errorf("Expected no lockout, got %v", locked)
+		}
+	})
+
+	t.Run("Logging in clears the account's failures", func(t *testing.T) {
+		guard, store, _ := testGuard()
+		for range AccountPolicy.FreeAttempts {
+			guard.Fail(ctx, "alice", "")
+		}
+
+		guard.Succeed(ctx, "alice")
+
+		if _, exists := store.attempts["alice"]; exists {
+			t.Error("Expected the account's failures to be cleared")
+		}
+	})
+
+	t.Run("Failures at the same time are all counted", func(t *testing.T) {
+		guard, store, _ := testGuard()
+		var wg sync.WaitGroup
+		for range AccountPolicy.FreeAttempts + 1 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				guard.Fail(ctx, "alice", "")
+			}()
+		}
+		wg.Wait()
+
+		if failures := store.attempts["alice"].Failures; failures != AccountPolicy.FreeAttempts+1 {
+			t.Errorf("Expected %d failures, got %d", AccountPolicy.FreeAttempts+1, failures)
+		}
+		if locked := lockedFor(t, guard, "alice", ""); locked != AccountPolicy.BaseLockout {
+			t.Errorf("Expected the account to be locked for %v, got %v", AccountPolicy.BaseLockout, locked)
+		}
+	})
+
+	t.Run("Addresses trying many accounts are locked", func(t *testing.T) {
+		guard, _, _ := testGuard()
+		for i := range AddressPolicy.FreeAttempts + 1 {
+			username := string(rune('a' + i))
+			guard.Fail(ctx, username, "1.2.3.4")
+			guard.Succeed(ctx, "mine")
+		}
+
+		if locked := lockedFor(t, guard, "someone", "1.2.3.4"); locked != AddressPolicy.BaseLockout {
+			t.Errorf("Expected the address to be locked for %v, got %v", AddressPolicy.BaseLockout, locked)
+		}
+		if locked := lockedFor(t, guard, "someone", "5.6.7.8"); locked != 0 {
+			t.Errorf("Expected other addresses to be unaffected, got %v", locked)
+		}
+	})
+
+	t.Run("Address lockouts survive a restart", func(t *testing.T) {
+		guard, accounts, now := testGuard()
+		for i := range AddressPolicy.FreeAttempts + 1 {
+			guard.Fail(ctx, string(rune('a'+i)), "1.2.3.4")
+		}
+
+		restarted := newLoginGuard(accounts, guard.addresses)
+		restarted.Now = func() time.Time { return *now }
+		if locked := lockedFor(t, restarted, "someone", "1.2.3.4"); locked != AddressPolicy.BaseLockout {
+			t.Errorf("Expected the address to still be locked for %v, got %v", AddressPolicy.BaseLockout, locked)
+		}
+	})
+
+	t.Run("Sweeping forgets old failures but not lockouts", func(t *testing.T) {
+		guard, store, now := testGuard()
+		guard.Fail(ctx, "nobody", "1.2.3.4")
+		for range AccountPolicy.FreeAttempts + 1 {
+			guard.Fail(ctx, "alice", "")
+		}
+
+		*now = now.Add(AccountPolicy.ForgetAfter)
+		store.LockUntil(ctx, "alice", now.Add(time.Minute))
+		if err := guard.Sweep(ctx); err != nil {
+			t.Fatalf("Failed to sweep: %v", err)
+		}
+
+		if _, ok := store.attempts["nobody"]; ok {
+			t.Error("Expected the stale failure to be forgotten")
+		}
+		if _, ok := guard.addresses.(*memoryAttemptStore).attempts["1.2.3.4"]; ok {
+			t.Error("Expected the stale address failure to be forgotten")
+		}
+		if _, ok := store.attempts["alice"]; !ok {
+			t.Error("Expected the locked account to be kept")
+		}
+	})
+}
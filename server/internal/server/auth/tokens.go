@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// How long a session token lets its holder log in without a password
+const SessionLifetime = 30 * 24 * time.Hour
+
+var (
+	ErrInvalidToken = errors.New("invalid session token")
+	ErrExpiredToken = errors.New("session token has expired")
+)
+
+// What a session token says about its holder
+type Claims struct {
+	// Names the session's row in the database, so it can be revoked before it expires
+	SessionId string
+	UserId    int32
+	ExpiresAt time.Time
+}
+
+// Issues and checks session tokens. Tokens are signed with the key, so anyone holding it can mint
+// them - it has to be kept secret and stay the same across restarts for tokens to survive them.
+type TokenSigner struct {
+	key []byte
+
+	// Stands in for time.Now, so tests can move the clock along
+	Now func() time.Time
+}
+
+func NewTokenSigner(key []byte) *TokenSigner {
+	return &TokenSigner{key: key, Now: time.Now}
+}
+
+// A key for when none has been configured. Tokens signed with it stop working on restart.
+func RandomKey() []byte {
+	key := make([]byte, 32)
+	rand.Read(key)
+	return key
+}
+
+// Starts a new session for the user, returning the token which proves it
+func (s *TokenSigner) Issue(userId int32) (string, Claims) {
+	claims := Claims{
+		SessionId: rand.Text(),
+		UserId:    userId,
+		ExpiresAt: s.Now().Add(SessionLifetime).Truncate(time.Second),
+	}
+	payload := fmt.Sprintf("%s.%d.%d", claims.SessionId, claims.UserId, claims.ExpiresAt.Unix())
+	return payload + "." + s.sign(payload), claims
+}
+
+// Checks the token was signed with our key and hasn't expired. Whether its session has been revoked
+// is up to the database.
+func (s *TokenSigner) Verify(token string) (Claims, error) {
+	separator := strings.LastIndexByte(token, '.')
+	if separator < 0 {
+		return Claims{}, ErrInvalidToken
+	}
+	payload, signature := token[:separator], token[separator+1:]
+	if !hmac.Equal([]byte(signature), []byte(s.sign(payload))) {
+		return Claims{}, ErrInvalidToken
+	}
+
+	fields := strings.Split(payload, ".")
+	if len(fields) != 3 {
+		return Claims{}, ErrInvalidToken
+	}
+	userId, err := strconv.ParseInt(fields[1], 10, 32)
+	if err != nil {
+		return Claims{}, ErrInvalidToken
+	}
+	expiresAt, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return Claims{}, ErrInvalidToken
+	}
+
+	claims := Claims{
+		SessionId: fields[0],
+		UserId:    int32(userId),
+		ExpiresAt: time.Unix(expiresAt, 0),
+	}
+	if !s.Now().Before(claims.ExpiresAt) {
+		return Claims{}, ErrExpiredToken
+	}
+	return claims, nil
+}
+
+func (s *TokenSigner) sign(payload string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
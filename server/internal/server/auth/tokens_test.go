@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestTokens tests issuing and checking session tokens
+func TestTokens(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	newSigner := func(key string) *TokenSigner {
+		signer := NewTokenSigner([]byte(key))
+		signer.Now = func() time.Time { return now }
+		return signer
+	}
+
+	t.Run("Issued tokens check out", func(t *testing.T) {
+		signer := newSigner("secret")
+		token, issued := signer.Issue(42)
+
+		claims, err := signer.Verify(token)
+		if err != nil {
+			t.Fatalf("Expected the token to be accepted, got %v", err)
+		}
+		if claims.SessionId != issued.SessionId || claims.UserId != 42 {
+			t.Errorf("Expected the claims the token was issued with, got %+v", claims)
+		}
+		if !claims.ExpiresAt.Equal(now.Add(SessionLifetime)) {
+			t.Errorf("Expected the token to expire after %v, got %v", SessionLifetime, claims.ExpiresAt)
+		}
+	})
+
+	t.Run("Every session is different", func(t *testing.T) {
+		signer := newSigner("secret")
+		_, first := signer.Issue(42)
+		_, second := signer.Issue(42)
+		if first.SessionId == second.SessionId {
+			t.Error("Expected two logins to get separate sessions")
+		}
+	})
+
+	t.Run("Tampered tokens are rejected", func(t *testing.T) {
+		signer := newSigner("secret")
+		token, claims := signer.Issue(42)
+
+		tampered := strings.Replace(token, ".42.", ".43.", 1)
+		if _, err := signer.Verify(tampered); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("Expected a token claiming another user to be rejected, got %v", err)
+		}
+		if _, err := newSigner("other secret").Verify(token); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("Expected a token signed with another key to be rejected, got %v", err)
+		}
+		for _, token := range []string{"", "garbage", claims.SessionId + ".42"} {
+			if _, err := signer.Verify(token); !errors.Is(err, ErrInvalidToken) {
+				t.Errorf("Expected %q to be rejected, got %v", token, err)
+			}
+		}
+	})
+
+	t.Run("Tokens expire", func(t *testing.T) {
+		signer := newSigner("secret")
+		token, _ := signer.Issue(42)
+
+		now = now.Add(SessionLifetime - time.Second)
+		if _, err := signer.Verify(token); err != nil {
+			t.Errorf("Expected the token to still work just before it expires, got %v", err)
+		}
+
+		now = now.Add(time.Second)
+		if _, err := signer.Verify(token); !errors.Is(err, ErrExpiredToken) {
+			t.Errorf("Expected the token to have expired, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,183 @@
+package auth
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Longest username in characters, so names fit on the hiscore board
+const MaxUsernameLength = 20
+
+// Punctuation allowed in usernames besides letters and digits. Spaces can only go between words.
+const usernamePunctuation = " _-."
+
+//go:embed reserved_names.txt
+var reservedNamesTxt string
+
+var reservedNames, blockedWords = parseReservedNames(reservedNamesTxt)
+
+// Splits the list into names reserved outright and words blocked within names, both as skeletons
+func parseReservedNames(list string) (map[string]struct{}, []string) {
+	reserved := make(map[string]struct{})
+	var blocked []string
+	for line := range strings.Lines(list) {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if word, ok := strings.CutPrefix(line, "*"); ok {
+			blocked = append(blocked, Skeleton(word))
+		} else {
+			reserved[Skeleton(line)] = struct{}{}
+		}
+	}
+	return reserved, blocked
+}
+
+// Puts the name into its canonical Unicode form, folding compatibility characters such as
+// full-width letters and ligatures into the ordinary ones. Names are stored and looked up this way.
+func NormalizeUsername(name string) string {
+	return norm.NFKC.String(name)
+}
+
+// The key accounts are looked up by, ignoring case
+func UsernameKey(name string) string {
+	return strings.ToLower(NormalizeUsername(name))
+}
+
+// Returns why the normalized name can't be registered, or nil if it can. Whether it looks like
+// somebody else's name is up to the database.
+func CheckUsername(name string) error {
+	if name == "" {
+		return errors.New("empty")
+	}
+	if !utf8.ValidString(name) {
+		return errors.New("not valid text")
+	}
+	if utf8.RuneCountInString(name) > MaxUsernameLength {
+		return fmt.Errorf("longer than %d characters", MaxUsernameLength)
+	}
+	if name != strings.TrimSpace(name) {
+		return errors.New("leading or trailing whitespace")
+	}
+	if strings.Contains(name, "  ") {
+		return errors.New("more than one space in a row")
+	}
+
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune(usernamePunctuation, r) {
+			return fmt.Errorf("%q isn't allowed - use letters, digits, spaces and %s", r, usernamePunctuation[1:])
+		}
+	}
+	if mixesAlphabets(name) {
+		return errors.New("mixes letters from different alphabets")
+	}
+
+	skeleton := Skeleton(name)
+	if skeleton == "" {
+		return errors.New("needs at least one letter or digit")
+	}
+	if _, reserved := reservedNames[skeleton]; reserved {
+		return errors.New("reserved")
+	}
+	if containsBlockedWord(name) {
+		return errors.New("not allowed")
+	}
+	return nil
+}
+
+// Whether any word of the name starts with a blocked word. Words are matched from their start
+// rather than anywhere inside, so innocent names such as "Scunthorpe" get through. The name as a
+// whole counts as a word too, catching blocked words spelled out letter by letter.
+func containsBlockedWord(name string) bool {
+	for _, word := range append(usernameWords(name), name) {
+		// Gamer tags are often wrapped in x's, as in "xXBlobXx"
+		skeleton := strings.Trim(Skeleton(word), "x")
+		for _, blocked := range blockedWords {
+			if strings.HasPrefix(skeleton, blocked) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Splits the name into its words, which are separated by punctuation or by a capital letter
+// following a lower case one, as in "BigBlob"
+func usernameWords(name string) []string {
+	var words []string
+	start := 0
+	previous := rune(0)
+	for i, r := range name {
+		switch {
+		case strings.ContainsRune(usernamePunctuation, r):
+			words = append(words, name[start:i])
+			start = i + utf8.RuneLen(r)
+		case unicode.IsUpper(r) && unicode.IsLower(previous):
+			words = append(words, name[start:i])
+			start = i
+		}
+		previous = r
+	}
+	words = append(words, name[start:])
+	return slices.DeleteFunc(words, func(word string) bool { return word == "" })
+}
+
+// Scripts whose letters can pass for one another
+var lookalikeScripts = []*unicode.RangeTable{unicode.Latin, unicode.Greek, unicode.Cyrillic}
+
+// Whether the name has letters from more than one of the scripts which look alike, the usual way
+// of impersonating a name with a few letters swapped for foreign ones
+func mixesAlphabets(name string) bool {
+	var found *unicode.RangeTable
+	for _, r := range name {
+		for _, script := range lookalikeScripts {
+			if unicode.Is(script, r) {
+				if found != nil && found != script {
+					return true
+				}
+				found = script
+			}
+		}
+	}
+	return false
+}
+
+// Characters which pass for others once case and accents are gone
+var confusables = map[rune]rune{
+	// Cyrillic
+	'а': 'a', 'в': 'b', 'е': 'e', 'ё': 'e', 'з': 'e', 'і': 'l', 'ј': 'j', 'к': 'k', 'м': 'm', 'н': 'h',
+	'о': 'o', 'р': 'p', 'с': 'c', 'т': 't', 'у': 'y', 'х': 'x', 'ѕ': 's', 'ԁ': 'd', 'ԛ': 'q', 'ԝ': 'w',
+	// Greek
+	'α': 'a', 'β': 'b', 'ε': 'e', 'η': 'n', 'ι': 'l', 'κ': 'k', 'ν': 'v', 'ο': 'o', 'ρ': 'p', 'τ': 't',
+	'υ': 'u', 'χ': 'x', 'ω': 'w',
+	// Latin and digits
+	'0': 'o', '1': 'l', 'i': 'l', '3': 'e', '5': 's', '8': 'b',
+}
+
+// Letter pairs which pass for a single letter
+var confusablePairs = strings.NewReplacer("rn", "m", "vv", "w")
+
+// A form of the name which is the same for names that look alike, such as "Blob", "bl0b" and
+// "B L O B" with a Cyrillic O. Names with the same skeleton are too easily mistaken for each other.
+func Skeleton(name string) string {
+	var skeleton strings.Builder
+	for _, r := range norm.NFKD.String(strings.ToLower(name)) {
+		// Accents decompose into marks of their own, which are dropped along with punctuation
+		if unicode.Is(unicode.Mn, r) || strings.ContainsRune(usernamePunctuation, r) {
+			continue
+		}
+		if confusable, ok := confusables[r]; ok {
+			r = confusable
+		}
+		skeleton.WriteRune(r)
+	}
+	return confusablePairs.Replace(skeleton.String())
+}
@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestCheckUsername tests which names can be registered
+func TestCheckUsername(t *testing.T) {
+	t.Run("Ordinary names are accepted", func(t *testing.T) {
+		for _, name := range []string{"Blob", "big_blob-99", "Mr. Blob", "José", "Алиса", "小明", strings.Repeat("é", MaxUsernameLength)} {
+			if err := CheckUsername(NormalizeUsername(name)); err != nil {
+				t.Errorf("Expected %q to be accepted, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("Odd characters are rejected", func(t *testing.T) {
+		for _, name := range []string{"", " Blob", "Blob ", "Big  Blob", "Blob\x00", "Blob​", "Bl‮ob", "Blob!", "<b>Blob</b>", "...", strings.Repeat("a", MaxUsernameLength+1)} {
+			if err := CheckUsername(NormalizeUsername(name)); err == nil {
+				t.Errorf("Expected %q to be rejected", name)
+			}
+		}
+	})
+
+	t.Run("Names mixing look-alike alphabets are rejected", func(t *testing.T) {
+		// Latin with a Cyrillic о
+		if err := CheckUsername("Blоb"); err == nil {
+			t.Error("Expected a name mixing Latin and Cyrillic to be rejected")
+		}
+	})
+
+	t.Run("Reserved and blocked names are rejected, however they are disguised", func(t *testing.T) {
+		for _, name := range []string{"admin", "ADMIN", "Adm1n", "a.d.m.i.n", "Ａｄｍｉｎ", "xXfuckXx", "Sh1t"} {
+			if err := CheckUsername(NormalizeUsername(name)); err == nil {
+				t.Errorf("Expected %q to be rejected", name)
+			}
+		}
+		if err := CheckUsername("Admiral"); err != nil {
+			t.Errorf("Expected names merely starting like reserved ones to be accepted, got %v", err)
+		}
+	})
+
+	t.Run("Blocked words are caught at the start of any word", func(t *testing.T) {
+		for _, name := range []string{"Fuckface", "Big_Shitty_Blob", "MotherFucker", "f u c k", "NaziBlob"} {
+			if err := CheckUsername(NormalizeUsername(name)); err == nil {
+				t.Errorf("Expected %q to be rejected", name)
+			}
+		}
+	})
+
+	t.Run("Blocked words inside innocent words are let through", func(t *testing.T) {
+		for _, name := range []string{"Scunthorpe", "Matsushita"} {
+			if err := CheckUsername(NormalizeUsername(name)); err != nil {
+				t.Errorf("Expected %q to be accepted, got %v", name, err)
+			}
+		}
+	})
+}
+
+// TestSkeleton tests telling look-alike names apart
+func TestSkeleton(t *testing.T) {
+	t.Run("Look-alikes share a skeleton", func(t *testing.T) {
+		for _, name := range []string{"bl0b", "B L O B", "Blöb", "Blоb", "BLOB", "B_l-o.b"} {
+			if Skeleton(name) != Skeleton("Blob") {
+				t.Errorf("Expected %q to look like Blob, got skeleton %q", name, Skeleton(name))
+			}
+		}
+		if Skeleton("Modern") != Skeleton("Modem") || Skeleton("Iggy") != Skeleton("lggy") {
+			t.Error("Expected letters which pass for others to be caught")
+		}
+	})
+
+	t.Run("Different names have different skeletons", func(t *testing.T) {
+		if Skeleton("Blob") == Skeleton("Blub") || Skeleton("Alice") == Skeleton("Alicia") {
+			t.Error("Expected different names to stay different")
+		}
+	})
+
+	t.Run("Lookups ignore case and compatibility forms", func(t *testing.T) {
+		if UsernameKey("Ｂｌｏｂ") != "blob" {
+			t.Errorf("Expected full-width letters to fold into ordinary ones, got %q", UsernameKey("Ｂｌｏｂ"))
+		}
+	})
+}
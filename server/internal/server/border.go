@@ -0,0 +1,99 @@
+package server
+
+import (
+	"maps"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"slices"
+	"time"
+)
+
+// How often a shrinking border tells clients where it has got to
+const BorderUpdateRate = time.Second
+
+// Closes the room's border in by one step, wearing down players caught outside it and culling the
+// pickups it leaves behind. Returns the packets to broadcast, or nil if the room's border doesn't
+// shrink or there is nothing new to say.
+func (r *Room) stepBorder(now time.Time, delta float64) []*packets.Packet {
+	if r.Border.Rate <= 0 {
+		return nil
+	}
+
+	previous := r.Bounds()
+	bounds := r.Border.Shrink(previous, delta)
+	r.setBounds(bounds)
+
+	// The world step is about to push these players back inside, but not before it has hurt them
+	r.SharedGameObjects.Players.ForEach(func(playerId uint64, player *objects.Player) {
+		r.Border.DamagePlayer(player, bounds, delta)
+		r.SharedGameObjects.Players.Reindex(playerId)
+	})
+
+	// Pickups can turn up outside even once the border has stopped, since the loops topping them up
+	// may have picked their spot just before it moved
+	events := r.cullOutside(bounds)
+	if bounds == previous && !r.lastBorderUpdate.IsZero() {
+		return events
+	}
+
+	// Keep clients roughly in step while the border moves, and tell them exactly where it stopped
+	stopped := r.Border.Shrink(bounds, delta) == bounds
+	if now.Sub(r.lastBorderUpdate) >= BorderUpdateRate || stopped {
+		r.lastBorderUpdate = now
+		events = append(events, &packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewGameBounds(bounds.MinX, bounds.MaxX, bounds.MinY, bounds.MaxY),
+		})
+	}
+	return events
+}
+
+// Removes every spore, virus and power-up which isn't wholly inside the bounds
+func (r *Room) cullOutside(bounds objects.Bounds) []*packets.Packet {
+	var events []*packets.Packet
+
+	spores := make(map[uint64]*objects.Spore)
+	r.SharedGameObjects.Spores.ForEach(func(sporeId uint64, spore *objects.Spore) {
+		if !bounds.Contains(spore.X, spore.Y, spore.Radius) {
+			spores[sporeId] = spore
+		}
+	})
+	for _, sporeId := range slices.Sorted(maps.Keys(spores)) {
+		r.SharedGameObjects.Spores.Remove(sporeId)
+		r.movingSpores.Remove(sporeId)
+		events = append(events, &packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewSporeConsumed(sporeId),
+		})
+	}
+
+	viruses := make(map[uint64]*objects.Virus)
+	r.SharedGameObjects.Viruses.ForEach(func(virusId uint64, virus *objects.Virus) {
+		if !bounds.Contains(virus.X, virus.Y, virus.Radius) {
+			viruses[virusId] = virus
+		}
+	})
+	for _, virusId := range slices.Sorted(maps.Keys(viruses)) {
+		r.SharedGameObjects.Viruses.Remove(virusId)
+		events = append(events, &packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewVirusConsumed(virusId),
+		})
+	}
+
+	powerUps := make(map[uint64]*objects.PowerUp)
+	r.SharedGameObjects.PowerUps.ForEach(func(powerUpId uint64, powerUp *objects.PowerUp) {
+		if !bounds.Contains(powerUp.X, powerUp.Y, powerUp.Radius) {
+			powerUps[powerUpId] = powerUp
+		}
+	})
+	for _, powerUpId := range slices.Sorted(maps.Keys(powerUps)) {
+		r.SharedGameObjects.PowerUps.Remove(powerUpId)
+		events = append(events, &packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewPowerUpConsumed(powerUpId),
+		})
+	}
+
+	return events
+}
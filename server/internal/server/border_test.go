@@ -0,0 +1,126 @@
+package server
+
+import (
+	"server/internal/server/objects"
+	"testing"
+	"time"
+)
+
+func testBorderRoom() *Room {
+	return NewRoom(RoomConfig{
+		Name:   "Test",
+		Bounds: objects.Bounds{MinX: -1000, MaxX: 1000, MinY: -1000, MaxY: 1000},
+		Border: objects.ShrinkingBorder{Rate: 100, MinSize: 1000, Damage: 1},
+	})
+}
+
+// TestStepBorder tests the room's border closing in over time
+func TestStepBorder(t *testing.T) {
+	t.Run("Rooms without a shrinking border say nothing", func(t *testing.T) {
+		room := testRoom()
+		if events := room.stepBorder(time.Now(), 1); events != nil {
+			t.Errorf("Expected no border packets, got %v", events)
+		}
+		if room.Bounds() != objects.DefaultBounds {
+			t.Errorf("Expected the bounds to stay put, got %+v", room.Bounds())
+		}
+	})
+
+	t.Run("Border shrinks and is announced at most once a second", func(t *testing.T) {
+		room := testBorderRoom()
+		start := time.Now()
+
+		first := room.stepBorder(start, 1)
+		if len(first) != 1 || first[0].GetGameBounds().GetMaxX() != 900 {
+			t.Fatalf("Expected the new bounds to be announced, got %v", first)
+		}
+		if events := room.stepBorder(start.Add(100*time.Millisecond), 0.1); len(events) != 0 {
+			t.Errorf("Expected no announcement within the same second, got %v", events)
+		}
+		if room.Bounds().MaxX != 890 {
+			t.Errorf("Expected the border to keep shrinking quietly, got %+v", room.Bounds())
+		}
+	})
+
+	t.Run("Stopping is announced straight away", func(t *testing.T) {
+		room := testBorderRoom()
+		start := time.Now()
+		room.stepBorder(start, 4.5)
+
+		events := room.stepBorder(start.Add(100*time.Millisecond), 4.5)
+		if len(events) != 1 || events[0].GetGameBounds().GetMaxX() != 500 {
+			t.Fatalf("Expected the final bounds to be announced, got %v", events)
+		}
+		if events := room.stepBorder(start.Add(2*time.Second), 1); len(events) != 0 {
+			t.Errorf("Expected nothing more once the border has stopped, got %v", events)
+		}
+	})
+
+	t.Run("Spores and viruses left outside are culled", func(t *testing.T) {
+		room := testBorderRoom()
+		insideId := room.SharedGameObjects.Spores.Add(&objects.Spore{X: 0, Radius: 10})
+		outsideId := room.SharedGameObjects.Spores.Add(&objects.Spore{X: 950, Radius: 10})
+		virusId := room.SharedGameObjects.Viruses.Add(&objects.Virus{X: -950, Radius: objects.VirusRadius})
+
+		events := room.stepBorder(time.Now(), 1)
+
+		if _, exists := room.SharedGameObjects.Spores.Get(insideId); !exists {
+			t.Error("Expected the spore inside the border to survive")
+		}
+		if _, exists := room.SharedGameObjects.Spores.Get(outsideId); exists {
+			t.Error("Expected the spore outside the border to be culled")
+		}
+		if _, exists := room.SharedGameObjects.Viruses.Get(virusId); exists {
+			t.Error("Expected the virus outside the border to be culled")
+		}
+		if len(events) != 3 || events[0].GetSporeConsumed().GetSporeId() != outsideId || events[1].GetVirusConsumed().GetVirusId() != virusId {
+			t.Errorf("Expected the culls followed by the new bounds, got %v", events)
+		}
+	})
+
+	t.Run("Pickups left outside are culled after the border stops", func(t *testing.T) {
+		room := testBorderRoom()
+		start := time.Now()
+		room.stepBorder(start, 10)
+		room.stepBorder(start.Add(time.Second), 1)
+		sporeId := room.SharedGameObjects.Spores.Add(&objects.Spore{X: 900, Radius: 10})
+
+		events := room.stepBorder(start.Add(2*time.Second), 1)
+
+		if _, exists := room.SharedGameObjects.Spores.Get(sporeId); exists {
+			t.Error("Expected the spore outside the stopped border to be culled")
+		}
+		if len(events) != 1 || events[0].GetSporeConsumed().GetSporeId() != sporeId {
+			t.Errorf("Expected just the cull to be announced, got %v", events)
+		}
+	})
+
+	t.Run("Players caught outside lose mass", func(t *testing.T) {
+		room := testBorderRoom()
+		caught := &objects.Player{X: 940, Radius: 60}
+		safe := &objects.Player{X: 0, Radius: 60}
+		room.SharedGameObjects.Players.Add(caught, 1)
+		room.SharedGameObjects.Players.Add(safe, 2)
+
+		room.stepBorder(time.Now(), 1)
+
+		if caught.Radius >= 60 {
+			t.Errorf("Expected the player past the border to shrink, got radius %f", caught.Radius)
+		}
+		if safe.Radius != 60 {
+			t.Errorf("Expected the player inside the border to keep radius 60, got %f", safe.Radius)
+		}
+	})
+
+	t.Run("Round end pushes the border back out", func(t *testing.T) {
+		room := testBorderRoom()
+		start := time.Now()
+		room.stepBorder(start, 4.5)
+		room.resetArena()
+
+		events := room.stepBorder(start.Add(100*time.Millisecond), 0.1)
+		if len(events) == 0 || events[len(events)-1].GetGameBounds().GetMaxX() != 990 {
+			t.Errorf("Expected the reset border to be announced, got %v", events)
+		}
+	})
+}
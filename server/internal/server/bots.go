@@ -0,0 +1,112 @@
+package server
+
+import (
+	"log"
+	"math"
+	"server/internal/server/objects"
+	"slices"
+	"time"
+)
+
+// How often bots rethink where they're going
+const botThinkRate = 200 * time.Millisecond
+
+// How far away bots notice other players and spores
+const botSightRange float64 = 800
+
+// Creates a server-driven player for the given room. Bots live in the clients package, so main
+// supplies this in the same way it supplies Serve's client constructor.
+type BotFactory func(hub *Hub, room *Room) ClientInterfacer
+
+// Keeps every room topped up with bots until it has its minimum number of players, taking them out
+// again as real players join
+func (h *Hub) RunBots(newBot BotFactory, rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	bots := make(map[uint64][]ClientInterfacer)
+	for range ticker.C {
+		h.Rooms.ForEach(func(roomId uint64, room *Room) {
+			before := len(bots[roomId])
+
+			// Forget bots which have since left, e.g. because the room was full when they tried to join
+			roomBots := slices.DeleteFunc(bots[roomId], func(bot ClientInterfacer) bool {
+				return bot.Room() != room
+			})
+
+			humans := room.Clients.Len() - len(roomBots)
+			wanted := max(room.MinPlayers-humans, 0)
+
+			for len(roomBots) < wanted {
+				bot := newBot(h, room)
+				bot.Initialize(h.Clients.Add(bot))
+				go bot.WritePump()
+				go bot.ReadPump()
+				roomBots = append(roomBots, bot)
+			}
+
+			for len(roomBots) > wanted {
+				bot := roomBots[len(roomBots)-1]
+				roomBots = roomBots[:len(roomBots)-1]
+				go bot.Close("Making room for real players")
+			}
+
+			if len(roomBots) != before {
+				log.Printf("Room [%s] now has %d human players and %d bots", room.Name, humans, len(roomBots))
+			}
+			bots[roomId] = roomBots
+		})
+	}
+}
+
+// Whether it is time for the room's bots to rethink where they're going
+func (r *Room) botsThink(now time.Time) bool {
+	if now.Sub(r.lastBotThink) < botThinkRate {
+		return false
+	}
+	r.lastBotThink = now
+	return true
+}
+
+// Bots are steered by the world tick rather than by direction messages, since deciding where to go
+// means looking at every other player nearby
+func (r *Room) steerBot(botId uint64, bot *objects.Player) {
+	if direction, ok := steer(botId, bot, r.SharedGameObjects); ok {
+		bot.Direction = direction
+	}
+}
+
+// Picks a direction for the bot: away from the nearest player that could eat it, otherwise towards
+// the nearest player it could eat, otherwise towards the nearest spore. Teammates are ignored.
+// Returns false if there is nothing in sight worth changing course for.
+func steer(botId uint64, bot *objects.Player, world *SharedGameObjects) (float64, bool) {
+	botMass := objects.RadToMass(bot.Radius)
+
+	var threat, prey *objects.Player
+	threatDistance, preyDistance := math.Inf(1), math.Inf(1)
+	for otherId, other := range world.Players.Within(bot.X, bot.Y, botSightRange) {
+		if otherId == botId || objects.Teammates(bot, other) {
+			continue
+		}
+
+		otherMass := objects.RadToMass(other.Radius)
+		distance := math.Hypot(other.X-bot.X, other.Y-bot.Y) - other.Radius
+		if otherMass > botMass*ConsumeMassRatio && distance < threatDistance {
+			threat, threatDistance = other, distance
+		} else if botMass > otherMass*ConsumeMassRatio && distance < preyDistance {
+			prey, preyDistance = other, distance
+		}
+	}
+
+	switch {
+	case threat != nil:
+		return math.Atan2(bot.Y-threat.Y, bot.X-threat.X), true
+	case prey != nil:
+		return math.Atan2(prey.Y-bot.Y, prey.X-bot.X), true
+	}
+
+	if _, spore, found := world.Spores.Nearest(bot.X, bot.Y, botSightRange); found {
+		return math.Atan2(spore.Y-bot.Y, spore.X-bot.X), true
+	}
+	return 0, false
+}
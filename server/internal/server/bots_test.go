@@ -0,0 +1,78 @@
+package server
+
+import (
+	"math"
+	"server/internal/server/objects"
+	"testing"
+)
+
+// TestBotSteering tests the simple behaviours bots choose between
+func TestBotSteering(t *testing.T) {
+	t.Run("Bot flees a bigger player", func(t *testing.T) {
+		world := testRoom().SharedGameObjects
+		bot := &objects.Player{Radius: 20}
+		world.Players.Add(bot, 1)
+		world.Players.Add(&objects.Player{X: 200, Radius: 60}, 2)
+		world.Spores.Add(&objects.Spore{X: 100, Radius: 10})
+
+		direction, ok := steer(1, bot, world)
+		if !ok || math.Abs(math.Abs(direction)-math.Pi) > 0.0001 {
+			t.Errorf("Expected the bot to head left away from the threat, got %f (ok: %v)", direction, ok)
+		}
+	})
+
+	t.Run("Bot chases a smaller player", func(t *testing.T) {
+		world := testRoom().SharedGameObjects
+		bot := &objects.Player{Radius: 60}
+		world.Players.Add(bot, 1)
+		world.Players.Add(&objects.Player{Y: 300, Radius: 20}, 2)
+		world.Spores.Add(&objects.Spore{X: -100, Radius: 10})
+
+		direction, ok := steer(1, bot, world)
+		if !ok || math.Abs(direction-math.Pi/2) > 0.0001 {
+			t.Errorf("Expected the bot to head down towards its prey, got %f (ok: %v)", direction, ok)
+		}
+	})
+
+	t.Run("Bot seeks the nearest spore when no players are around", func(t *testing.T) {
+		world := testRoom().SharedGameObjects
+		bot := &objects.Player{Radius: 20}
+		world.Players.Add(bot, 1)
+		world.Players.Add(&objects.Player{X: 100, Radius: 22}, 2)
+		world.Spores.Add(&objects.Spore{Y: -100, Radius: 10})
+		world.Spores.Add(&objects.Spore{X: 500, Radius: 10})
+
+		direction, ok := steer(1, bot, world)
+		if !ok || math.Abs(direction+math.Pi/2) > 0.0001 {
+			t.Errorf("Expected the bot to head up towards the spore, got %f (ok: %v)", direction, ok)
+		}
+	})
+
+	t.Run("Bot keeps its course with nothing in sight", func(t *testing.T) {
+		world := testRoom().SharedGameObjects
+		bot := &objects.Player{Radius: 20}
+		world.Players.Add(bot, 1)
+
+		if _, ok := steer(1, bot, world); ok {
+			t.Error("Expected the bot to have nothing to steer towards")
+		}
+	})
+
+	t.Run("World tick steers bots", func(t *testing.T) {
+		room := testRoom()
+		bot := &objects.Player{Radius: 20, Speed: 100, IsBot: true}
+		human := &objects.Player{X: -1000, Radius: 20, Speed: 100, Direction: math.Pi}
+		room.SharedGameObjects.Players.Add(bot, 1)
+		room.SharedGameObjects.Players.Add(human, 2)
+		room.SharedGameObjects.Spores.Add(&objects.Spore{Y: 300, Radius: 10})
+
+		room.stepWorld(0.05)
+
+		if math.Abs(bot.Direction-math.Pi/2) > 0.0001 {
+			t.Errorf("Expected the bot to head down towards the spore, got %f", bot.Direction)
+		}
+		if human.Direction != math.Pi {
+			t.Errorf("Expected the human player to keep its course, got %f", human.Direction)
+		}
+	})
+}
@@ -0,0 +1,337 @@
+package chat
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"server/internal/server/auth"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Longest chat message in characters, so messages fit in the chat box
+const MaxMessageLength = 200
+
+// Decides whether a player may send a chat message. Rules which keep track of what each player
+// has said should be safe to check from several clients at once.
+type Rule interface {
+	// Returns why the message can't be sent, or nil if it can
+	Check(senderId uint64, text string, now time.Time) error
+}
+
+// Rules which keep track of what each player sends implement this. Only messages every rule lets
+// through are recorded, so messages turned away don't count against the player.
+type recorder interface {
+	Record(senderId uint64, text string, now time.Time)
+}
+
+// Rules which keep track of each player implement this, so they can let go of players who leave
+type forgetter interface {
+	Forget(senderId uint64)
+}
+
+// Runs chat messages past a chain of rules before they are sent on. The first rule to turn a
+// message away decides why.
+type Moderator struct {
+	rules []Rule
+
+	// Held from checking a message to recording it, so messages checked at the same time can't
+	// all slip in under a limit
+	mux sync.Mutex
+
+	// Stands in for time.Now, so tests can move the clock along
+	Now func() time.Time
+}
+
+func NewModerator(rules ...Rule) *Moderator {
+	return &Moderator{
+		rules: rules,
+		Now:   time.Now,
+	}
+}
+
+// The rules every room's chat goes through: a length limit, a rate limit, repeated message
+// detection and the word filter. They keep track of players, so each room needs its own.
+func DefaultRules() []Rule {
+	return []Rule{
+		&LengthRule{Max: MaxMessageLength},
+		NewRateLimit(5, 10*time.Second),
+		NewRepeatRule(2, 30*time.Second),
+		NewWordFilter(blockedWords),
+	}
+}
+
+// Returns why the player can't send the message, or nil if it can
+func (m *Moderator) Check(senderId uint64, text string) error {
+	now := m.Now()
+
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	for _, rule := range m.rules {
+		if err := rule.Check(senderId, text, now); err != nil {
+			return err
+		}
+	}
+	for _, rule := range m.rules {
+		if rule, ok := rule.(recorder); ok {
+			rule.Record(senderId, text, now)
+		}
+	}
+	return nil
+}
+
+// Lets go of everything the rules remember about a player who has left
+func (m *Moderator) Forget(senderId uint64) {
+	for _, rule := range m.rules {
+		if rule, ok := rule.(forgetter); ok {
+			rule.Forget(senderId)
+		}
+	}
+}
+
+// Rounds a wait up to whole seconds for telling players how long they have to wait
+func seconds(wait time.Duration) time.Duration {
+	return max(wait.Round(time.Second), time.Second)
+}
+
+// Turns away messages which are blank or too long
+type LengthRule struct {
+	Max int
+}
+
+func (r *LengthRule) Check(_ uint64, text string, _ time.Time) error {
+	if strings.TrimSpace(text) == "" {
+		return errors.New("empty")
+	}
+	if !utf8.ValidString(text) {
+		return errors.New("not valid text")
+	}
+	if utf8.RuneCountInString(text) > r.Max {
+		return fmt.Errorf("longer than %d characters", r.Max)
+	}
+	return nil
+}
+
+// Lets each player send so many messages in a stretch of time
+type RateLimit struct {
+	messages int
+	per      time.Duration
+	sent     map[uint64][]time.Time
+	mux      sync.Mutex
+}
+
+func NewRateLimit(messages int, per time.Duration) *RateLimit {
+	return &RateLimit{
+		messages: messages,
+		per:      per,
+		sent:     make(map[uint64][]time.Time),
+	}
+}
+
+func (r *RateLimit) Check(senderId uint64, _ string, now time.Time) error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	sent := r.recent(senderId, now)
+	if len(sent) >= r.messages {
+		return fmt.Errorf("sending too quickly - wait %v", seconds(sent[0].Add(r.per).Sub(now)))
+	}
+	return nil
+}
+
+func (r *RateLimit) Record(senderId uint64, _ string, now time.Time) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.sent[senderId] = append(r.recent(senderId, now), now)
+}
+
+// The times of the player's messages sent within the last stretch, which are the only ones that
+// count. Older ones are let go of.
+func (r *RateLimit) recent(senderId uint64, now time.Time) []time.Time {
+	sent := r.sent[senderId]
+	for len(sent) > 0 && now.Sub(sent[0]) >= r.per {
+		sent = sent[1:]
+	}
+	r.sent[senderId] = sent
+	return sent
+}
+
+func (r *RateLimit) Forget(senderId uint64) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	delete(r.sent, senderId)
+}
+
+// The last message a player sent and how many times in a row they have sent it
+type repeat struct {
+	text   string
+	times  int
+	sentAt time.Time
+}
+
+// Turns away the same message sent over and over, whatever the case or spacing
+type RepeatRule struct {
+	maxTimes int
+	within   time.Duration
+	last     map[uint64]repeat
+	mux      sync.Mutex
+}
+
+// Allows the same message to be sent at most maxTimes times in a row while each comes within the
+// given time of the one before
+func NewRepeatRule(maxTimes int, within time.Duration) *RepeatRule {
+	return &RepeatRule{
+		maxTimes: maxTimes,
+		within:   within,
+		last:     make(map[uint64]repeat),
+	}
+}
+
+func (r *RepeatRule) Check(senderId uint64, text string, now time.Time) error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if r.timesSent(senderId, text, now) >= r.maxTimes {
+		return errors.New("you already said that")
+	}
+	return nil
+}
+
+func (r *RepeatRule) Record(senderId uint64, text string, now time.Time) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	times := r.timesSent(senderId, text, now) + 1
+	r.last[senderId] = repeat{text: sameText(text), times: times, sentAt: now}
+}
+
+// How many times in a row the player has just sent the message, or 0 if it is a new one
+func (r *RepeatRule) timesSent(senderId uint64, text string, now time.Time) int {
+	last := r.last[senderId]
+	if last.text != sameText(text) || now.Sub(last.sentAt) >= r.within {
+		return 0
+	}
+	return last.times
+}
+
+// The form messages are compared in, so changing the case or spacing doesn't make a new one
+func sameText(text string) string {
+	return strings.ToLower(strings.Join(strings.Fields(text), " "))
+}
+
+func (r *RepeatRule) Forget(senderId uint64) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	delete(r.last, senderId)
+}
+
+//go:embed blocked_words.txt
+var blockedWordsTxt string
+
+// The words the default rules keep out of chat
+var blockedWords = strings.Split(blockedWordsTxt, "\n")
+
+// Turns away messages with blocked words in them, however they are disguised
+type WordFilter struct {
+	// Skeletons of words blocked on their own, and of words blocked at the start of any word
+	words     map[string]struct{}
+	fragments []string
+}
+
+// Takes lines in the format of blocked_words.txt: plain words are blocked on their own, words
+// starting with * at the start of any word, and blank lines and lines starting with # are skipped
+func NewWordFilter(lines []string) *WordFilter {
+	filter := &WordFilter{words: make(map[string]struct{})}
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if fragment, ok := strings.CutPrefix(line, "*"); ok {
+			filter.fragments = append(filter.fragments, auth.Skeleton(fragment))
+		} else {
+			filter.words[auth.Skeleton(line)] = struct{}{}
+		}
+	}
+	return filter
+}
+
+func (f *WordFilter) Check(_ uint64, text string, _ time.Time) error {
+	// Punctuation other than what the skeleton drops splits words, so "f.u.c.k" stays one word
+	// while "you, dick!" is two
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return unicode.IsSpace(r) || (unicode.IsPunct(r) && !strings.ContainsRune("_-.", r))
+	})
+	for _, word := range words {
+		skeleton := auth.Skeleton(word)
+		if _, blocked := f.words[skeleton]; blocked {
+			return errors.New("watch your language")
+		}
+		// Matched from the start of the word like in usernames, so innocent words with a blocked
+		// word inside them, such as "mishit", get through. The x's of "xXshitXx" don't hide it.
+		skeleton = strings.Trim(skeleton, "x")
+		for _, fragment := range f.fragments {
+			if strings.HasPrefix(skeleton, fragment) {
+				return errors.New("watch your language")
+			}
+		}
+	}
+	return nil
+}
+
+// Players who aren't allowed to chat until their mute runs out. Players are known by a key of the
+// caller's choosing, such as their account, so a mute can outlast the connection it was given on.
+type MuteList struct {
+	until map[string]time.Time
+	mux   sync.Mutex
+}
+
+func NewMuteList() *MuteList {
+	return &MuteList{until: make(map[string]time.Time)}
+}
+
+// Mutes the player until the given time, replacing any mute they already had
+func (l *MuteList) Mute(key string, until time.Time) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	l.until[key] = until
+}
+
+func (l *MuteList) Unmute(key string) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	delete(l.until, key)
+}
+
+// How much longer the player is muted for, or 0 if they aren't
+func (l *MuteList) MutedFor(key string, now time.Time) time.Duration {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	until, muted := l.until[key]
+	if !muted {
+		return 0
+	}
+	if !now.Before(until) {
+		delete(l.until, key)
+		return 0
+	}
+	return until.Sub(now)
+}
+
+// Returns why the player can't chat, or nil if they aren't muted
+func (l *MuteList) Check(key string, now time.Time) error {
+	if wait := l.MutedFor(key, now); wait > 0 {
+		return fmt.Errorf("you are muted for another %v", seconds(wait))
+	}
+	return nil
+}
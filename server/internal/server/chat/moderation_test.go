@@ -0,0 +1,143 @@
+package chat
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// A moderator with the default rules and a clock the test moves along
+func newTestModerator() (*Moderator, *time.Time) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	moderator := NewModerator(DefaultRules()...)
+	moderator.Now = func() time.Time { return now }
+	return moderator, &now
+}
+
+// TestModerator tests which chat messages are turned away
+func TestModerator(t *testing.T) {
+	t.Run("Ordinary messages get through", func(t *testing.T) {
+		moderator, _ := newTestModerator()
+		for _, text := range []string{"hi all", "gg", "Scunthorpe is nice", "Dickens wrote that mishit", strings.Repeat("é", MaxMessageLength)} {
+			if err := moderator.Check(1, text); err != nil {
+				t.Errorf("Expected %q to be sent, got %v", text, err)
+			}
+		}
+	})
+
+	t.Run("Blank and long messages are turned away", func(t *testing.T) {
+		moderator, _ := newTestModerator()
+		for _, text := range []string{"", "   ", strings.Repeat("a", MaxMessageLength+1)} {
+			if err := moderator.Check(1, text); err == nil {
+				t.Errorf("Expected %q to be turned away", text)
+			}
+		}
+	})
+
+	t.Run("Players can only send so many messages at once", func(t *testing.T) {
+		moderator, now := newTestModerator()
+		for i := range 5 {
+			if err := moderator.Check(1, strings.Repeat("a", i+1)); err != nil {
+				t.Fatalf("Expected message %d to be sent, got %v", i+1, err)
+			}
+		}
+		err := moderator.Check(1, "one too many")
+		if err == nil || !strings.Contains(err.Error(), "wait 10s") {
+			t.Errorf("Expected to be told to wait, got %v", err)
+		}
+		if err := moderator.Check(2, "somebody else"); err != nil {
+			t.Errorf("Expected other players to be unaffected, got %v", err)
+		}
+
+		*now = now.Add(10 * time.Second)
+		if err := moderator.Check(1, "later"); err != nil {
+			t.Errorf("Expected to be let back in once the messages are old, got %v", err)
+		}
+	})
+
+	t.Run("Saying the same thing over and over is turned away", func(t *testing.T) {
+		moderator, now := newTestModerator()
+		for range 2 {
+			*now = now.Add(3 * time.Second)
+			if err := moderator.Check(1, "follow me"); err != nil {
+				t.Fatalf("Expected a message to be sent twice, got %v", err)
+			}
+		}
+		*now = now.Add(3 * time.Second)
+		if err := moderator.Check(1, "FOLLOW   me"); err == nil {
+			t.Error("Expected another repeat to be turned away, whatever its case and spacing")
+		}
+		if err := moderator.Check(1, "something else"); err != nil {
+			t.Errorf("Expected a new message to be sent, got %v", err)
+		}
+	})
+
+	t.Run("Messages turned away don't count against the player", func(t *testing.T) {
+		moderator, _ := newTestModerator()
+		for i := range 4 {
+			if err := moderator.Check(1, strings.Repeat("a", i+1)); err != nil {
+				t.Fatalf("Expected message %d to be sent, got %v", i+1, err)
+			}
+		}
+		for _, text := range []string{strings.Repeat("a", MaxMessageLength+1), "", "fuck"} {
+			if err := moderator.Check(1, text); err == nil {
+				t.Fatalf("Expected %q to be turned away", text)
+			}
+		}
+		if err := moderator.Check(1, "last one"); err != nil {
+			t.Errorf("Expected the fifth message sent to get through, got %v", err)
+		}
+	})
+
+	t.Run("Blocked words are turned away, however they are disguised", func(t *testing.T) {
+		moderator, _ := newTestModerator()
+		for i, text := range []string{"fuck", "oh SH1T", "what a f.u.c.k.i.n.g mess", "you, dick!", "kys", "fuckface", "xXshitXx"} {
+			// A different player each time, so the rate limit doesn't get in the way
+			if err := moderator.Check(uint64(i+1), text); err == nil {
+				t.Errorf("Expected %q to be turned away", text)
+			}
+		}
+	})
+
+	t.Run("Forgetting a player clears what the rules remember", func(t *testing.T) {
+		moderator, _ := newTestModerator()
+		for i := range 5 {
+			moderator.Check(1, strings.Repeat("a", i+1))
+		}
+		moderator.Forget(1)
+		if err := moderator.Check(1, "back again"); err != nil {
+			t.Errorf("Expected a player coming back to start afresh, got %v", err)
+		}
+	})
+}
+
+// TestMuteList tests that mutes last until they run out or are lifted
+func TestMuteList(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Muted players can't chat until their mute runs out", func(t *testing.T) {
+		mutes := NewMuteList()
+		mutes.Mute("user 1", now.Add(time.Minute))
+
+		err := mutes.Check("user 1", now)
+		if err == nil || !strings.Contains(err.Error(), "muted for another 1m0s") {
+			t.Errorf("Expected to be told how long the mute lasts, got %v", err)
+		}
+		if err := mutes.Check("user 2", now); err != nil {
+			t.Errorf("Expected somebody else to be able to chat, got %v", err)
+		}
+		if err := mutes.Check("user 1", now.Add(time.Minute)); err != nil {
+			t.Errorf("Expected to chat again once the mute is over, got %v", err)
+		}
+	})
+
+	t.Run("Unmuted players can chat straight away", func(t *testing.T) {
+		mutes := NewMuteList()
+		mutes.Mute("user 1", now.Add(time.Minute))
+		mutes.Unmute("user 1")
+
+		if err := mutes.Check("user 1", now); err != nil {
+			t.Errorf("Expected to chat again once unmuted, got %v", err)
+		}
+	})
+}
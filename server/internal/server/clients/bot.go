@@ -0,0 +1,243 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"sync"
+
+	"server/internal/server"
+	"server/internal/server/auth"
+	"server/internal/server/objects"
+	"server/internal/server/states"
+	"server/pkg/packets"
+)
+
+var botNames = []string{
+	"Blobby", "Gloop", "Nibbles", "Squish", "Wobble", "Munch", "Bubbles", "Pudge", "Dotty", "Chomp",
+}
+
+// A player driven by the server rather than a WebSocket connection. It plays through the InGame
+// state like everyone else, but its room's world tick does the steering.
+type BotClient struct {
+	id        uint64
+	hub       *server.Hub
+	dbTx      *server.DbTx
+	state     server.ClientStateHandler
+	stateMux  sync.Mutex
+	logger    *log.Logger
+	closeOnce sync.Once
+	target    *server.Room
+	room      *server.Room
+	roomMux   sync.Mutex
+}
+
+func NewBotClient(hub *server.Hub, room *server.Room) server.ClientInterfacer {
+	return &BotClient{
+		hub:    hub,
+		dbTx:   hub.NewDbTx(),
+		logger: log.New(log.Writer(), "Bot unknown: ", log.LstdFlags),
+		target: room,
+	}
+}
+
+func (c *BotClient) Id() uint64 {
+	return c.id
+}
+
+// Bots skip the login screen and go straight into their room
+func (c *BotClient) Initialize(id uint64) {
+	c.id = id
+	c.logger.SetPrefix(fmt.Sprintf("Bot %d: ", c.id))
+
+	player := &objects.Player{
+		Name:  fmt.Sprintf("%s (bot)", botNames[rand.IntN(len(botNames))]),
+		Color: int32(rand.Uint32() | 0xff), // Random RGBA color, fully opaque
+		IsBot: true,
+	}
+	if err := c.JoinRoom(c.target, player); err != nil {
+		c.logger.Printf("Could not join %s: %v", c.target.Name, err)
+		go c.Close("Could not join room")
+		return
+	}
+
+	c.SetState(states.NewInGame(player))
+}
+
+func (c *BotClient) DbTx() *server.DbTx {
+	return c.dbTx
+}
+
+func (c *BotClient) SharedGameObjects() *server.SharedGameObjects {
+	room := c.Room()
+	if room == nil {
+		return nil
+	}
+	return room.SharedGameObjects
+}
+
+func (c *BotClient) Rooms() *objects.SharedCollection[*server.Room] {
+	return c.hub.Rooms
+}
+
+func (c *BotClient) Tokens() *auth.TokenSigner {
+	return c.hub.Tokens
+}
+
+func (c *BotClient) LoginGuard() *auth.LoginGuard {
+	return c.hub.LoginGuard
+}
+
+// Bots never log in, so they have no address to throttle
+func (c *BotClient) RemoteAddress() string {
+	return ""
+}
+
+func (c *BotClient) Room() *server.Room {
+	c.roomMux.Lock()
+	defer c.roomMux.Unlock()
+
+	return c.room
+}
+
+func (c *BotClient) JoinRoom(room *server.Room, player *objects.Player) error {
+	c.roomMux.Lock()
+	defer c.roomMux.Unlock()
+
+	if c.room == room {
+		return nil
+	}
+
+	if err := room.Join(c, player); err != nil {
+		return err
+	}
+
+	if c.room != nil {
+		c.room.Leave(c)
+	}
+	c.room = room
+	return nil
+}
+
+func (c *BotClient) OpenPrivateRoom(config server.RoomConfig) *server.Room {
+	return c.hub.OpenPrivateRoom(config, c.id)
+}
+
+func (c *BotClient) WatchRoom(room *server.Room) {
+	c.roomMux.Lock()
+	defer c.roomMux.Unlock()
+
+	if c.room == room {
+		return
+	}
+
+	room.Watch(c)
+	if c.room != nil {
+		c.room.Leave(c)
+	}
+	c.room = room
+}
+
+func (c *BotClient) LeaveRoom() {
+	c.roomMux.Lock()
+	defer c.roomMux.Unlock()
+
+	if c.room == nil {
+		return
+	}
+
+	c.room.Leave(c)
+	c.room = nil
+}
+
+func (c *BotClient) ProcessMessage(senderId uint64, message packets.Msg) {
+	// The room may still pass on a message it picked up before the bot closed
+	state := c.currentState()
+	if state == nil {
+		return
+	}
+	state.HandleMessage(senderId, message)
+}
+
+func (c *BotClient) currentState() server.ClientStateHandler {
+	c.stateMux.Lock()
+	defer c.stateMux.Unlock()
+
+	return c.state
+}
+
+func (c *BotClient) SetState(state server.ClientStateHandler) {
+	if prevState := c.currentState(); prevState != nil {
+		prevState.OnExit()
+	}
+
+	// Hand the new state its client before it becomes visible to other goroutines, since the room
+	// may start passing it messages straight away
+	if state != nil {
+		state.SetClient(c)
+	}
+
+	c.stateMux.Lock()
+	c.state = state
+	c.stateMux.Unlock()
+
+	if state != nil {
+		state.OnEnter()
+	}
+}
+
+// Bots see the world directly, so there's nobody to send anything to
+func (c *BotClient) SocketSend(_ packets.Msg) {}
+
+func (c *BotClient) SocketSendAs(_ packets.Msg, _ uint64) {}
+
+func (c *BotClient) PassToPeer(message packets.Msg, peerId uint64) {
+	if peer, exists := c.hub.Clients.Get(peerId); exists {
+		peer.ProcessMessage(c.id, message)
+	}
+}
+
+func (c *BotClient) Broadcast(message packets.Msg) {
+	broadcastChan := c.hub.BroadcastChan
+	if room := c.Room(); room != nil {
+		broadcastChan = room.BroadcastChan
+	}
+
+	select {
+	case broadcastChan <- &packets.Packet{SenderId: c.id, Msg: message}:
+	default:
+		c.logger.Printf("BroadcastChan full, dropping message: %T", message)
+	}
+}
+
+// Bots have no socket to read from, and the world tick steers them instead
+func (c *BotClient) ReadPump() {}
+
+// Bots have no socket to write to
+func (c *BotClient) WritePump() {}
+
+// Bots never lose their connection, so there is nothing to resume
+func (c *BotClient) SetResumeToken(_ string) {}
+
+func (c *BotClient) Resume(_ string) bool {
+	return false
+}
+
+func (c *BotClient) Close(reason string) {
+	c.closeOnce.Do(func() {
+		c.logger.Printf("Closing bot because: %s", reason)
+
+		c.Broadcast(packets.NewDisconnect(reason))
+
+		// Drop the state before leaving, so nothing still handling the room's messages finds it gone.
+		// Anything the room passes on after this is ignored.
+		c.SetState(nil)
+		c.LeaveRoom()
+
+		select {
+		case c.hub.UnregisterChan <- c:
+		default:
+			go func() { c.hub.UnregisterChan <- c }()
+		}
+	})
+}
@@ -0,0 +1,46 @@
+package clients
+
+import (
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"testing"
+)
+
+// TestBotClose tests that a closed bot shrugs off messages the room picked up before it left
+func TestBotClose(t *testing.T) {
+	hub := mockHub()
+	room := server.NewRoom(server.RoomConfig{Name: "Test", Bounds: objects.DefaultBounds})
+	bot := NewBotClient(hub, room)
+	bot.Initialize(1)
+
+	// The room's broadcast loop works on a copy of its clients, which can still hold the bot
+	var members []server.ClientInterfacer
+	room.Clients.ForEach(func(_ uint64, client server.ClientInterfacer) { members = append(members, client) })
+
+	bot.Close("Making room for real players")
+
+	if _, ok := room.SharedGameObjects.Players.Get(1); ok {
+		t.Error("Expected the bot's player to be taken out of the room")
+	}
+	for _, member := range members {
+		member.ProcessMessage(2, packets.NewChat("hello"))
+	}
+
+	t.Run("World updates in flight while closing", func(t *testing.T) {
+		room := server.NewRoom(server.RoomConfig{Name: "Test", Bounds: objects.DefaultBounds})
+		bot := NewBotClient(hub, room)
+		bot.Initialize(1)
+		update := packets.NewWorldUpdate(map[uint64]*objects.Player{1: {Radius: 20}}, nil)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for range 1000 {
+				bot.ProcessMessage(0, update)
+			}
+		}()
+		bot.Close("Making room for real players")
+		<-done
+	})
+}
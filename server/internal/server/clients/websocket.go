@@ -1,13 +1,19 @@
 package clients
 
 import (
+	"crypto/subtle"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"server/internal/server"
+	"server/internal/server/auth"
+	"server/internal/server/objects"
 	"server/internal/server/states"
 	"server/pkg/packets"
 
@@ -15,16 +21,39 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// How long a client whose connection dropped mid-game waits to be resumed before it is closed
+const ResumeGracePeriod = 30 * time.Second
+
+// Whether the server sits behind a proxy or load balancer that sets X-Forwarded-For. Left off,
+// the header is ignored, since anybody connecting directly could write whatever they like in it.
+var TrustProxy = false
+
 type WebSocketClient struct {
-	id         uint64
+	// Atomic since resuming another client's game takes over its ID while other goroutines read ours
+	id         atomic.Uint64
 	conn       *websocket.Conn
 	hub        *server.Hub
 	dbTx       *server.DbTx
 	state      server.ClientStateHandler
+	stateMux   sync.Mutex
 	sendChan   chan *packets.Packet
 	logger     *log.Logger
 	closeOnce  sync.Once
 	closeChan  chan struct{}
+	room       *server.Room
+	roomMux    sync.Mutex
+
+	// Where the connection comes from, for throttling logins
+	remoteAddress string
+
+	// Presented by a new connection to take over from this one after it drops
+	resumeToken string
+
+	// Set once the connection has dropped while the client waits to be resumed
+	detached    atomic.Bool
+	sessionMux  sync.Mutex
+	sessionOver bool
+	resumeTimer *time.Timer
 }
 
 func NewWebSocketClient(hub *server.Hub, writer http.ResponseWriter, request *http.Request) (server.ClientInterfacer, error) {
@@ -47,18 +76,36 @@ func NewWebSocketClient(hub *server.Hub, writer http.ResponseWriter, request *ht
 		sendChan:  make(chan *packets.Packet, 1024), // Increased from 256 to handle high-score message bursts
 		logger:    log.New(log.Writer(), "Client unknown: ", log.LstdFlags),
 		closeChan: make(chan struct{}),
+
+		remoteAddress: remoteAddress(request),
 	}
 
 	return c, nil
 }
 
+// The address the request came from. Behind a load balancer, connections all come from the
+// balancer, which adds the real address to the end of X-Forwarded-For, so with TrustProxy on
+// that is used instead.
+func remoteAddress(request *http.Request) string {
+	if forwarded := request.Header.Get("X-Forwarded-For"); TrustProxy && forwarded != "" {
+		hops := strings.Split(forwarded, ",")
+		return strings.TrimSpace(hops[len(hops)-1])
+	}
+
+	host, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		return request.RemoteAddr
+	}
+	return host
+}
+
 func (c *WebSocketClient) Id() uint64 {
-	return c.id
+	return c.id.Load()
 }
 
 func (c *WebSocketClient) Initialize(id uint64) {
-	c.id = id
-	c.logger.SetPrefix(fmt.Sprintf("Client %d: ", c.id))
+	c.id.Store(id)
+	c.logger.SetPrefix(fmt.Sprintf("Client %d: ", id))
 	c.SetState(&states.Connected{})
 }
 
@@ -67,18 +114,110 @@ func (c *WebSocketClient) DbTx() *server.DbTx {
 }
 
 func (c *WebSocketClient) SharedGameObjects() *server.SharedGameObjects {
-	return c.hub.SharedGameObjects
+	room := c.Room()
+	if room == nil {
+		return nil
+	}
+	return room.SharedGameObjects
+}
+
+func (c *WebSocketClient) Rooms() *objects.SharedCollection[*server.Room] {
+	return c.hub.Rooms
+}
+
+func (c *WebSocketClient) Tokens() *auth.TokenSigner {
+	return c.hub.Tokens
+}
+
+func (c *WebSocketClient) LoginGuard() *auth.LoginGuard {
+	return c.hub.LoginGuard
+}
+
+func (c *WebSocketClient) RemoteAddress() string {
+	return c.remoteAddress
+}
+
+func (c *WebSocketClient) Room() *server.Room {
+	c.roomMux.Lock()
+	defer c.roomMux.Unlock()
+
+	return c.room
+}
+
+func (c *WebSocketClient) JoinRoom(room *server.Room, player *objects.Player) error {
+	c.roomMux.Lock()
+	defer c.roomMux.Unlock()
+
+	if c.room == room {
+		return nil
+	}
+
+	if err := room.Join(c, player); err != nil {
+		return err
+	}
+
+	if c.room != nil {
+		c.room.Leave(c)
+	}
+	c.room = room
+	c.logger.Printf("Joined room %d (%s)", room.Id, room.Name)
+	return nil
+}
+
+func (c *WebSocketClient) OpenPrivateRoom(config server.RoomConfig) *server.Room {
+	return c.hub.OpenPrivateRoom(config, c.Id())
+}
+
+func (c *WebSocketClient) WatchRoom(room *server.Room) {
+	c.roomMux.Lock()
+	defer c.roomMux.Unlock()
+
+	if c.room == room {
+		return
+	}
+
+	room.Watch(c)
+	if c.room != nil {
+		c.room.Leave(c)
+	}
+	c.room = room
+	c.logger.Printf("Watching room %d (%s)", room.Id, room.Name)
+}
+
+func (c *WebSocketClient) LeaveRoom() {
+	c.roomMux.Lock()
+	defer c.roomMux.Unlock()
+
+	if c.room == nil {
+		return
+	}
+
+	c.room.Leave(c)
+	c.logger.Printf("Left room %d (%s)", c.room.Id, c.room.Name)
+	c.room = nil
 }
 
 func (c *WebSocketClient) ProcessMessage(senderId uint64, message packets.Msg) {
-	c.state.HandleMessage(senderId, message)
+	// The hub or room may still pass on a message it picked up before the client closed
+	state := c.currentState()
+	if state == nil {
+		return
+	}
+	state.HandleMessage(senderId, message)
+}
+
+func (c *WebSocketClient) currentState() server.ClientStateHandler {
+	c.stateMux.Lock()
+	defer c.stateMux.Unlock()
+
+	return c.state
 }
 
 func (c *WebSocketClient) SetState(state server.ClientStateHandler) {
 	prevStateName := "None"
-	if c.state != nil {
-		prevStateName = c.state.Name()
-		c.state.OnExit()
+	if prevState := c.currentState(); prevState != nil {
+		prevStateName = prevState.Name()
+		prevState.OnExit()
 	}
 
 	newStateName := "None"
@@ -88,35 +227,52 @@ func (c *WebSocketClient) SetState(state server.ClientStateHandler) {
 
 	c.logger.Printf("Switching from state %s to %s", prevStateName, newStateName)
 
+	// Hand the new state its client before it becomes visible to other goroutines, since the hub
+	// may start passing it messages straight away
+	if state != nil {
+		state.SetClient(c)
+	}
+
+	c.stateMux.Lock()
 	c.state = state
+	c.stateMux.Unlock()
 
-	if c.state != nil {
-		c.state.SetClient(c)
-		c.state.OnEnter()
+	if state != nil {
+		state.OnEnter()
 	}
 }
 
 func (c *WebSocketClient) SocketSend(message packets.Msg) {
-	c.SocketSendAs(message, c.id)
+	c.SocketSendAs(message, c.Id())
 }
 
 func (c *WebSocketClient) SocketSendAs(message packets.Msg, senderId uint64) {
+	// Nobody is listening, and the resumed client is sent what it needs afresh
+	if c.detached.Load() {
+		return
+	}
+
 	select {
 	case c.sendChan <- &packets.Packet{SenderId: senderId, Msg: message}:
 	default:
-		c.logger.Printf("Client %d send channel full, dropping message: %T", c.id, message)
+		c.logger.Printf("Client %d send channel full, dropping message: %T", c.Id(), message)
 	}
 }
 
 func (c *WebSocketClient) PassToPeer(message packets.Msg, peerId uint64) {
 	if peer, exists := c.hub.Clients.Get(peerId); exists {
-		peer.ProcessMessage(c.id, message)
+		peer.ProcessMessage(c.Id(), message)
 	}
 }
 
 func (c *WebSocketClient) Broadcast(message packets.Msg) {
+	broadcastChan := c.hub.BroadcastChan
+	if room := c.Room(); room != nil {
+		broadcastChan = room.BroadcastChan
+	}
+
 	select {
-	case c.hub.BroadcastChan <- &packets.Packet{SenderId: c.id, Msg: message}:
+	case broadcastChan <- &packets.Packet{SenderId: c.Id(), Msg: message}:
 	default:
 		c.logger.Printf("BroadcastChan full, dropping message: %T", message)
 	}
@@ -125,7 +281,7 @@ func (c *WebSocketClient) Broadcast(message packets.Msg) {
 func (c *WebSocketClient) ReadPump() {
 	defer func() {
 		c.logger.Println("Closing read pump")
-		c.Close("Read pump closed")
+		c.dropConnection("Read pump closed")
 	}()
 
 	// Set up pong handler to respond to ping messages
@@ -158,7 +314,7 @@ func (c *WebSocketClient) ReadPump() {
 
 		// to allow client to lazily not set the sender ID, assume they want to send it as themselves
 		if packet.SenderId == 0 {
-			packet.SenderId = c.id
+			packet.SenderId = c.Id()
 		}
 
 		c.ProcessMessage(packet.SenderId, packet.Msg)
@@ -168,7 +324,7 @@ func (c *WebSocketClient) ReadPump() {
 func (c *WebSocketClient) WritePump() {
 	defer func() {
 		c.logger.Println("Closing write pump")
-		c.Close("write pump closed")
+		c.dropConnection("write pump closed")
 	}()
 
 	// Send ping to client every 30 seconds to keep connection alive
@@ -224,6 +380,135 @@ func (c *WebSocketClient) writePacket(packet *packets.Packet) {
 	}
 }
 
+func (c *WebSocketClient) SetResumeToken(token string) {
+	c.sessionMux.Lock()
+	defer c.sessionMux.Unlock()
+
+	c.resumeToken = token
+}
+
+// Called as either pump stops. A client in the middle of a game keeps its place for a while in
+// case it comes back on a new connection, otherwise it is closed straight away.
+func (c *WebSocketClient) dropConnection(reason string) {
+	c.sessionMux.Lock()
+	defer c.sessionMux.Unlock()
+
+	if c.detached.Load() || c.sessionOver {
+		return
+	}
+
+	resumable, ok := c.currentState().(server.Resumable)
+	if !ok || c.resumeToken == "" {
+		c.Close(reason)
+		return
+	}
+
+	c.logger.Printf("Connection lost (%s), waiting %v for the client to resume", reason, ResumeGracePeriod)
+	c.detached.Store(true)
+	c.conn.Close()
+	resumable.OnConnectionLost()
+	c.resumeTimer = time.AfterFunc(ResumeGracePeriod, c.expire)
+}
+
+// Gives up on the client coming back
+func (c *WebSocketClient) expire() {
+	c.sessionMux.Lock()
+	over := c.sessionOver
+	c.sessionOver = true
+	c.sessionMux.Unlock()
+
+	if !over {
+		c.Close("Connection lost")
+	}
+}
+
+// Claims the detached client for a new connection presenting the token. Returns false if the token
+// is wrong, or the client has already expired or been resumed.
+func (c *WebSocketClient) handOver(token string) bool {
+	c.sessionMux.Lock()
+	defer c.sessionMux.Unlock()
+
+	if !c.detached.Load() || c.sessionOver || c.resumeToken == "" {
+		return false
+	}
+	if subtle.ConstantTimeCompare([]byte(c.resumeToken), []byte(token)) != 1 {
+		return false
+	}
+	// The client may have been sent out of its room while it was away
+	if _, ok := c.currentState().(server.Resumable); !ok {
+		return false
+	}
+	c.sessionOver = true
+	c.resumeTimer.Stop()
+	return true
+}
+
+// Stands in for the state of a client whose game was handed over to a new connection. The room may
+// still pass it messages it picked up before the hand over, which are no use to anybody now.
+type handedOver struct{}
+
+func (handedOver) Name() string                          { return "HandedOver" }
+func (handedOver) SetClient(_ server.ClientInterfacer)   {}
+func (handedOver) OnEnter()                              {}
+func (handedOver) HandleMessage(_ uint64, _ packets.Msg) {}
+func (handedOver) OnExit()                               {}
+
+func (c *WebSocketClient) Resume(token string) bool {
+	if token == "" {
+		return false
+	}
+
+	var detached *WebSocketClient
+	c.hub.Clients.ForEach(func(_ uint64, client server.ClientInterfacer) {
+		if other, ok := client.(*WebSocketClient); ok && other != c && detached == nil && other.handOver(token) {
+			detached = other
+		}
+	})
+	if detached == nil {
+		return false
+	}
+
+	// The detached client keeps nothing but a state which ignores whatever still reaches it
+	detached.stateMux.Lock()
+	state := detached.state
+	detached.state = handedOver{}
+	detached.stateMux.Unlock()
+
+	// Take over the detached client's ID and room, and let go of our own ID
+	formerId := c.Id()
+	resumedId := detached.Id()
+	c.id.Store(resumedId)
+	c.SetResumeToken(token)
+	c.logger.SetPrefix(fmt.Sprintf("Client %d: ", resumedId))
+	c.logger.Printf("Resumed from the connection which was client %d", formerId)
+	c.hub.Clients.Add(c, resumedId)
+	c.hub.Clients.Remove(formerId)
+
+	room := detached.Room()
+	c.roomMux.Lock()
+	c.room = room
+	c.roomMux.Unlock()
+	if room != nil {
+		room.Clients.Add(c, resumedId)
+	}
+
+	c.SocketSend(packets.NewOkResponse())
+	c.SocketSend(packets.NewId(resumedId))
+
+	// Our own state bows out as it would for any other change, but the resumed state carries on
+	// where it left off rather than starting afresh, just with us as its client
+	if prevState := c.currentState(); prevState != nil {
+		prevState.OnExit()
+	}
+	state.SetClient(c)
+	c.stateMux.Lock()
+	c.state = state
+	c.stateMux.Unlock()
+	c.logger.Printf("Switching from state Connected to %s", state.Name())
+	state.(server.Resumable).OnConnectionResumed()
+	return true
+}
+
 func (c *WebSocketClient) Close(reason string) {
 	c.closeOnce.Do(func() {
 		c.logger.Printf("Closing client connection because: %s", reason)
@@ -234,7 +519,10 @@ func (c *WebSocketClient) Close(reason string) {
 		// Also broadcast to other clients
 		c.Broadcast(packets.NewDisconnect(reason))
 
+		// Drop the state before leaving, so nothing still handling the room's messages finds it gone.
+		// Anything the room passes on after this is ignored.
 		c.SetState(nil)
+		c.LeaveRoom()
 
 		// Non-blocking send to UnregisterChan
 		select {
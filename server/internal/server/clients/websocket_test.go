@@ -23,10 +23,7 @@ func mockHub() *server.Hub {
 		BroadcastChan:     make(chan *packets.Packet, 256),
 		RegisterChan:      make(chan server.ClientInterfacer, 256),
 		UnregisterChan:    make(chan server.ClientInterfacer, 256),
-		SharedGameObjects: &server.SharedGameObjects{
-			Players: objects.NewSharedCollection[*objects.Player](),
-			Spores:  objects.NewSharedCollection[*objects.Spore](),
-		},
+		Rooms:             objects.NewSharedCollection[*server.Room](),
 	}
 }
 
@@ -465,3 +462,166 @@ func TestWebSocketClientCreation(t *testing.T) {
 		time.Sleep(200 * time.Millisecond)
 	})
 }
+
+// TestRemoteAddress tests only believing X-Forwarded-For behind a trusted proxy
+func TestRemoteAddress(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "/ws", nil)
+	request.RemoteAddr = "10.0.0.1:5000"
+	request.Header.Set("X-Forwarded-For", "6.6.6.6, 1.2.3.4")
+
+	t.Run("The header is ignored by default", func(t *testing.T) {
+		if address := remoteAddress(request); address != "10.0.0.1" {
+			t.Errorf("Expected the connection's own address, got %s", address)
+		}
+	})
+
+	t.Run("The last hop is used behind a trusted proxy", func(t *testing.T) {
+		TrustProxy = true
+		defer func() { TrustProxy = false }()
+
+		if address := remoteAddress(request); address != "1.2.3.4" {
+			t.Errorf("Expected the address the proxy added, got %s", address)
+		}
+	})
+}
+
+// Stands in for InGame, noting when the connection comes and goes
+type resumableState struct {
+	client  server.ClientInterfacer
+	lost    chan struct{}
+	resumed chan struct{}
+}
+
+func (s *resumableState) Name() string                             { return "Resumable" }
+func (s *resumableState) SetClient(client server.ClientInterfacer) { s.client = client }
+func (s *resumableState) OnEnter()                                 {}
+func (s *resumableState) HandleMessage(_ uint64, _ packets.Msg)    {}
+func (s *resumableState) OnExit()                                  {}
+func (s *resumableState) OnConnectionLost()                        { close(s.lost) }
+func (s *resumableState) OnConnectionResumed()                     { close(s.resumed) }
+
+// readUntil reads packets from the connection until one satisfies match
+func readUntil(t *testing.T, conn *websocket.Conn, match func(*packets.Packet) bool) *packets.Packet {
+	t.Helper()
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("Failed to read message: %v", err)
+		}
+		packet := &packets.Packet{}
+		if err := proto.Unmarshal(data[:len(data)-1], packet); err != nil {
+			t.Fatalf("Failed to unmarshal message: %v", err)
+		}
+		if match(packet) {
+			return packet
+		}
+	}
+}
+
+// TestResume tests a new connection taking over from one which dropped
+func TestResume(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping WebSocket integration test in short mode")
+	}
+
+	hub := mockHub()
+	state := &resumableState{lost: make(chan struct{}), resumed: make(chan struct{})}
+	connected := make(chan *WebSocketClient, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client, err := NewWebSocketClient(hub, w, r)
+		if err != nil {
+			t.Errorf("Failed to create WebSocket client: %v", err)
+			return
+		}
+		client.Initialize(hub.Clients.Add(client))
+
+		// The first client gets into a game
+		if client.Id() == 1 {
+			client.SetResumeToken("token")
+			client.SetState(state)
+		}
+		connected <- client.(*WebSocketClient)
+
+		go client.WritePump()
+		client.ReadPump()
+	}))
+	defer server.Close()
+
+	wsURL := "ws" + server.URL[4:]
+	dial := func() *websocket.Conn {
+		conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		return conn
+	}
+	send := func(conn *websocket.Conn, message packets.Msg) {
+		data, _ := proto.Marshal(&packets.Packet{Msg: message})
+		if err := conn.WriteMessage(websocket.BinaryMessage, data); err != nil {
+			t.Fatalf("Failed to send message: %v", err)
+		}
+	}
+
+	firstConn := dial()
+	first := <-connected
+	firstId := first.Id()
+	firstConn.Close()
+
+	select {
+	case <-state.lost:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected the state to hear the connection was lost")
+	}
+	if client, exists := hub.Clients.Get(firstId); !exists || client != first {
+		t.Fatal("Expected the dropped client to stay registered while it waits to be resumed")
+	}
+
+	secondConn := dial()
+	defer secondConn.Close()
+	second := <-connected
+	secondId := second.Id()
+
+	t.Run("Unknown tokens are turned away", func(t *testing.T) {
+		send(secondConn, &packets.Packet_ResumeRequest{ResumeRequest: &packets.ResumeRequestMessage{Token: "wrong"}})
+		readUntil(t, secondConn, func(packet *packets.Packet) bool { return packet.GetDenyResponse() != nil })
+	})
+
+	t.Run("The new connection takes over the dropped client", func(t *testing.T) {
+		send(secondConn, &packets.Packet_ResumeRequest{ResumeRequest: &packets.ResumeRequestMessage{Token: "token"}})
+
+		select {
+		case <-state.resumed:
+		case <-time.After(2 * time.Second):
+			t.Fatal("Expected the state to be resumed")
+		}
+		if state.client != second {
+			t.Error("Expected the state to carry on with the new connection as its client")
+		}
+		if client, exists := hub.Clients.Get(firstId); !exists || client != second {
+			t.Errorf("Expected the new connection to take over ID %d", firstId)
+		}
+		if _, exists := hub.Clients.Get(secondId); exists {
+			t.Errorf("Expected the new connection's own ID %d to be let go", secondId)
+		}
+
+		packet := readUntil(t, secondConn, func(packet *packets.Packet) bool {
+			return packet.GetId() != nil && packet.GetId().Id != secondId
+		})
+		if packet.GetId().Id != firstId {
+			t.Errorf("Expected the client to be told its ID is %d, got %d", firstId, packet.GetId().Id)
+		}
+
+		// A room may still pass the dropped client messages it picked up before the hand over
+		first.ProcessMessage(0, packets.NewChat("stray"))
+		if name := first.currentState().Name(); name != "HandedOver" {
+			t.Errorf("Expected the dropped client to be left with a state which ignores everything, got %s", name)
+		}
+	})
+
+	t.Run("Tokens can't be used twice", func(t *testing.T) {
+		if second.Resume("token") {
+			t.Error("Expected the session to have been resumed already")
+		}
+	})
+}
@@ -10,11 +10,15 @@ INSERT INTO users (
 )
 RETURNING *;
 
+-- name: DeleteUser :exec
+DELETE FROM users
+WHERE id = $1;
+
 -- name: CreatePlayer :one
 INSERT INTO players (
-  user_id, name, color
+  user_id, name, color, name_skeleton
 ) VALUES (
-  $1, $2, $3
+  $1, $2, $3, $4
 )
 RETURNING *;
 
@@ -45,3 +49,120 @@ WHERE best_score > (
   SELECT best_score FROM players p2
   WHERE p2.id = $1
 );
+
+-- name: CreateRoundResult :exec
+INSERT INTO round_results (
+  room_name, ended_at, rank, player_id, name, score
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+);
+
+-- name: CreateSession :exec
+INSERT INTO sessions (
+  id, user_id, expires_at
+) VALUES (
+  $1, $2, $3
+);
+
+-- name: GetSession :one
+SELECT * FROM sessions
+WHERE id = $1 AND expires_at > NOW()
+LIMIT 1;
+
+-- name: DeleteSession :exec
+DELETE FROM sessions
+WHERE id = $1;
+
+-- name: DeleteUserSessions :exec
+DELETE FROM sessions
+WHERE user_id = $1;
+
+-- name: DeleteExpiredSessions :exec
+DELETE FROM sessions
+WHERE expires_at <= NOW();
+
+-- name: GetLoginAttempts :one
+SELECT * FROM login_attempts
+WHERE username = $1 LIMIT 1;
+
+-- name: AddLoginFailure :one
+INSERT INTO login_attempts (
+  username, failures, last_failure_at, locked_until
+) VALUES (
+  @username, 1, @last_failure_at, @last_failure_at
+)
+ON CONFLICT (username) DO UPDATE
+SET failures = CASE
+      WHEN login_attempts.last_failure_at <= @forget_before THEN 1
+      ELSE login_attempts.failures + 1
+    END,
+    last_failure_at = EXCLUDED.last_failure_at
+RETURNING *;
+
+-- name: LockLoginAttempts :exec
+UPDATE login_attempts
+SET locked_until = GREATEST(locked_until, @locked_until)
+WHERE username = @username;
+
+-- name: DeleteLoginAttempts :exec
+DELETE FROM login_attempts
+WHERE username = $1;
+
+-- name: DeleteExpiredLoginAttempts :exec
+DELETE FROM login_attempts
+WHERE last_failure_at <= @forget_before AND locked_until <= @now;
+
+-- name: GetAddressLoginAttempts :one
+SELECT * FROM address_login_attempts
+WHERE address = $1 LIMIT 1;
+
+-- name: AddAddressLoginFailure :one
+INSERT INTO address_login_attempts (
+  address, failures, last_failure_at, locked_until
+) VALUES (
+  @address, 1, @last_failure_at, @last_failure_at
+)
+ON CONFLICT (address) DO UPDATE
+SET failures = CASE
+      WHEN address_login_attempts.last_failure_at <= @forget_before THEN 1
+      ELSE address_login_attempts.failures + 1
+    END,
+    last_failure_at = EXCLUDED.last_failure_at
+RETURNING *;
+
+-- name: LockAddressLoginAttempts :exec
+UPDATE address_login_attempts
+SET locked_until = GREATEST(locked_until, @locked_until)
+WHERE address = @address;
+
+-- name: DeleteAddressLoginAttempts :exec
+DELETE FROM address_login_attempts
+WHERE address = $1;
+
+-- name: DeleteExpiredAddressLoginAttempts :exec
+DELETE FROM address_login_attempts
+WHERE last_failure_at <= @forget_before AND locked_until <= @now;
+
+-- name: GetUserByID :one
+SELECT * FROM users
+WHERE id = $1 LIMIT 1;
+
+-- name: UpdateUserPassword :exec
+UPDATE users
+SET password_hash = $1
+WHERE id = $2;
+
+-- name: GetPlayerByNameSkeleton :one
+SELECT * FROM players
+WHERE name_skeleton = $1
+LIMIT 1;
+
+-- name: GetPlayersWithoutNameSkeleton :many
+SELECT id, name FROM players
+WHERE name_skeleton IS NULL
+ORDER BY id;
+
+-- name: UpdatePlayerNameSkeleton :exec
+UPDATE players
+SET name_skeleton = $1
+WHERE id = $2;
@@ -15,3 +15,45 @@ CREATE TABLE IF NOT EXISTS players (
 -- Index for faster leaderboard queries
 CREATE INDEX IF NOT EXISTS idx_players_best_score ON players(best_score DESC);
 
+-- What each name looks like, so new names can't pass for existing ones. Filled in by the server for
+-- players from before the column existed. Unique, so two registrations racing each other can't
+-- both get a look-alike name in.
+ALTER TABLE players ADD COLUMN IF NOT EXISTS name_skeleton TEXT;
+CREATE UNIQUE INDEX IF NOT EXISTS players_name_skeleton_key ON players(name_skeleton);
+
+
+CREATE TABLE IF NOT EXISTS round_results (
+  id SERIAL PRIMARY KEY,
+  room_name TEXT NOT NULL,
+  ended_at TIMESTAMPTZ NOT NULL,
+  rank INTEGER NOT NULL,
+  player_id INTEGER REFERENCES players(id),
+  name TEXT NOT NULL,
+  score INTEGER NOT NULL
+);
+
+-- Remembered logins. Deleting a row revokes its session token.
+CREATE TABLE IF NOT EXISTS sessions (
+  id TEXT PRIMARY KEY,
+  user_id INTEGER NOT NULL REFERENCES users(id),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions(user_id);
+
+-- Recent failed logins by username, so lockouts survive a restart
+CREATE TABLE IF NOT EXISTS login_attempts (
+  username TEXT PRIMARY KEY,
+  failures INTEGER NOT NULL,
+  last_failure_at TIMESTAMPTZ NOT NULL,
+  locked_until TIMESTAMPTZ NOT NULL
+);
+
+-- Recent failed logins by the address they came from, whichever accounts they were for
+CREATE TABLE IF NOT EXISTS address_login_attempts (
+  address TEXT PRIMARY KEY,
+  failures INTEGER NOT NULL,
+  last_failure_at TIMESTAMPTZ NOT NULL,
+  locked_until TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,19 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// The unique index on players' name skeletons, which turns away names that pass for another's
+const PlayerNameSkeletonIndex = "players_name_skeleton_key"
+
+// Postgres's code for a row that would break a unique constraint or index
+const uniqueViolation = "23505"
+
+// Whether the error is from a row that would break the given unique constraint or index
+func IsUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == constraint
+}
@@ -4,12 +4,49 @@
 
 package db
 
+import (
+	"database/sql"
+	"time"
+)
+
+type AddressLoginAttempt struct {
+	Address       string    `json:"address"`
+	Failures      int32     `json:"failures"`
+	LastFailureAt time.Time `json:"last_failure_at"`
+	LockedUntil   time.Time `json:"locked_until"`
+}
+
+type LoginAttempt struct {
+	Username      string    `json:"username"`
+	Failures      int32     `json:"failures"`
+	LastFailureAt time.Time `json:"last_failure_at"`
+	LockedUntil   time.Time `json:"locked_until"`
+}
+
 type Player struct {
-	ID        int32  `json:"id"`
-	UserID    int32  `json:"user_id"`
-	Name      string `json:"name"`
-	BestScore int32  `json:"best_score"`
-	Color     int32  `json:"color"`
+	ID           int32          `json:"id"`
+	UserID       int32          `json:"user_id"`
+	Name         string         `json:"name"`
+	BestScore    int32          `json:"best_score"`
+	Color        int32          `json:"color"`
+	NameSkeleton sql.NullString `json:"name_skeleton"`
+}
+
+type RoundResult struct {
+	ID       int32         `json:"id"`
+	RoomName string        `json:"room_name"`
+	EndedAt  time.Time     `json:"ended_at"`
+	Rank     int32         `json:"rank"`
+	PlayerID sql.NullInt32 `json:"player_id"`
+	Name     string        `json:"name"`
+	Score    int32         `json:"score"`
+}
+
+type Session struct {
+	ID        string    `json:"id"`
+	UserID    int32     `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
 }
 
 type User struct {
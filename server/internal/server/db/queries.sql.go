@@ -7,25 +7,99 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
 )
 
+const addAddressLoginFailure = `-- name: AddAddressLoginFailure :one
+INSERT INTO address_login_attempts (
+  address, failures, last_failure_at, locked_until
+) VALUES (
+  $1, 1, $2, $2
+)
+ON CONFLICT (address) DO UPDATE
+SET failures = CASE
+      WHEN address_login_attempts.last_failure_at <= $3 THEN 1
+      ELSE address_login_attempts.failures + 1
+    END,
+    last_failure_at = EXCLUDED.last_failure_at
+RETURNING address, failures, last_failure_at, locked_until
+`
+
+type AddAddressLoginFailureParams struct {
+	Address       string    `json:"address"`
+	LastFailureAt time.Time `json:"last_failure_at"`
+	ForgetBefore  time.Time `json:"forget_before"`
+}
+
+func (q *Queries) AddAddressLoginFailure(ctx context.Context, arg AddAddressLoginFailureParams) (AddressLoginAttempt, error) {
+	row := q.db.QueryRowContext(ctx, addAddressLoginFailure, arg.Address, arg.LastFailureAt, arg.ForgetBefore)
+	var i AddressLoginAttempt
+	err := row.Scan(
+		&i.Address,
+		&i.Failures,
+		&i.LastFailureAt,
+		&i.LockedUntil,
+	)
+	return i, err
+}
+
+const addLoginFailure = `-- name: AddLoginFailure :one
+INSERT INTO login_attempts (
+  username, failures, last_failure_at, locked_until
+) VALUES (
+  $1, 1, $2, $2
+)
+ON CONFLICT (username) DO UPDATE
+SET failures = CASE
+      WHEN login_attempts.last_failure_at <= $3 THEN 1
+      ELSE login_attempts.failures + 1
+    END,
+    last_failure_at = EXCLUDED.last_failure_at
+RETURNING username, failures, last_failure_at, locked_until
+`
+
+type AddLoginFailureParams struct {
+	Username      string    `json:"username"`
+	LastFailureAt time.Time `json:"last_failure_at"`
+	ForgetBefore  time.Time `json:"forget_before"`
+}
+
+func (q *Queries) AddLoginFailure(ctx context.Context, arg AddLoginFailureParams) (LoginAttempt, error) {
+	row := q.db.QueryRowContext(ctx, addLoginFailure, arg.Username, arg.LastFailureAt, arg.ForgetBefore)
+	var i LoginAttempt
+	err := row.Scan(
+		&i.Username,
+		&i.Failures,
+		&i.LastFailureAt,
+		&i.LockedUntil,
+	)
+	return i, err
+}
+
 const createPlayer = `-- name: CreatePlayer :one
 INSERT INTO players (
-  user_id, name, color
+  user_id, name, color, name_skeleton
 ) VALUES (
-  $1, $2, $3
+  $1, $2, $3, $4
 )
-RETURNING id, user_id, name, best_score, color
+RETURNING id, user_id, name, best_score, color, name_skeleton
 `
 
 type CreatePlayerParams struct {
-	UserID int32  `json:"user_id"`
-	Name   string `json:"name"`
-	Color  int32  `json:"color"`
+	UserID       int32          `json:"user_id"`
+	Name         string         `json:"name"`
+	Color        int32          `json:"color"`
+	NameSkeleton sql.NullString `json:"name_skeleton"`
 }
 
 func (q *Queries) CreatePlayer(ctx context.Context, arg CreatePlayerParams) (Player, error) {
-	row := q.db.QueryRowContext(ctx, createPlayer, arg.UserID, arg.Name, arg.Color)
+	row := q.db.QueryRowContext(ctx, createPlayer,
+		arg.UserID,
+		arg.Name,
+		arg.Color,
+		arg.NameSkeleton,
+	)
 	var i Player
 	err := row.Scan(
 		&i.ID,
@@ -33,10 +107,59 @@ func (q *Queries) CreatePlayer(ctx context.Context, arg CreatePlayerParams) (Pla
 		&i.Name,
 		&i.BestScore,
 		&i.Color,
+		&i.NameSkeleton,
 	)
 	return i, err
 }
 
+const createRoundResult = `-- name: CreateRoundResult :exec
+INSERT INTO round_results (
+  room_name, ended_at, rank, player_id, name, score
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+`
+
+type CreateRoundResultParams struct {
+	RoomName string        `json:"room_name"`
+	EndedAt  time.Time     `json:"ended_at"`
+	Rank     int32         `json:"rank"`
+	PlayerID sql.NullInt32 `json:"player_id"`
+	Name     string        `json:"name"`
+	Score    int32         `json:"score"`
+}
+
+func (q *Queries) CreateRoundResult(ctx context.Context, arg CreateRoundResultParams) error {
+	_, err := q.db.ExecContext(ctx, createRoundResult,
+		arg.RoomName,
+		arg.EndedAt,
+		arg.Rank,
+		arg.PlayerID,
+		arg.Name,
+		arg.Score,
+	)
+	return err
+}
+
+const createSession = `-- name: CreateSession :exec
+INSERT INTO sessions (
+  id, user_id, expires_at
+) VALUES (
+  $1, $2, $3
+)
+`
+
+type CreateSessionParams struct {
+	ID        string    `json:"id"`
+	UserID    int32     `json:"user_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) error {
+	_, err := q.db.ExecContext(ctx, createSession, arg.ID, arg.UserID, arg.ExpiresAt)
+	return err
+}
+
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (
   username, password_hash
@@ -58,8 +181,132 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 	return i, err
 }
 
+const deleteAddressLoginAttempts = `-- name: DeleteAddressLoginAttempts :exec
+DELETE FROM address_login_attempts
+WHERE address = $1
+`
+
+func (q *Queries) DeleteAddressLoginAttempts(ctx context.Context, address string) error {
+	_, err := q.db.ExecContext(ctx, deleteAddressLoginAttempts, address)
+	return err
+}
+
+const deleteExpiredAddressLoginAttempts = `-- name: DeleteExpiredAddressLoginAttempts :exec
+DELETE FROM address_login_attempts
+WHERE last_failure_at <= $1 AND locked_until <= $2
+`
+
+type DeleteExpiredAddressLoginAttemptsParams struct {
+	ForgetBefore time.Time `json:"forget_before"`
+	Now          time.Time `json:"now"`
+}
+
+func (q *Queries) DeleteExpiredAddressLoginAttempts(ctx context.Context, arg DeleteExpiredAddressLoginAttemptsParams) error {
+	_, err := q.db.ExecContext(ctx, deleteExpiredAddressLoginAttempts, arg.ForgetBefore, arg.Now)
+	return err
+}
+
+const deleteExpiredLoginAttempts = `-- name: DeleteExpiredLoginAttempts :exec
+DELETE FROM login_attempts
+WHERE last_failure_at <= $1 AND locked_until <= $2
+`
+
+type DeleteExpiredLoginAttemptsParams struct {
+	ForgetBefore time.Time `json:"forget_before"`
+	Now          time.Time `json:"now"`
+}
+
+func (q *Queries) DeleteExpiredLoginAttempts(ctx context.Context, arg DeleteExpiredLoginAttemptsParams) error {
+	_, err := q.db.ExecContext(ctx, deleteExpiredLoginAttempts, arg.ForgetBefore, arg.Now)
+	return err
+}
+
+const deleteExpiredSessions = `-- name: DeleteExpiredSessions :exec
+DELETE FROM sessions
+WHERE expires_at <= NOW()
+`
+
+func (q *Queries) DeleteExpiredSessions(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteExpiredSessions)
+	return err
+}
+
+const deleteLoginAttempts = `-- name: DeleteLoginAttempts :exec
+DELETE FROM login_attempts
+WHERE username = $1
+`
+
+func (q *Queries) DeleteLoginAttempts(ctx context.Context, username string) error {
+	_, err := q.db.ExecContext(ctx, deleteLoginAttempts, username)
+	return err
+}
+
+const deleteSession = `-- name: DeleteSession :exec
+DELETE FROM sessions
+WHERE id = $1
+`
+
+func (q *Queries) DeleteSession(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, deleteSession, id)
+	return err
+}
+
+const deleteUser = `-- name: DeleteUser :exec
+DELETE FROM users
+WHERE id = $1
+`
+
+func (q *Queries) DeleteUser(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, deleteUser, id)
+	return err
+}
+
+const deleteUserSessions = `-- name: DeleteUserSessions :exec
+DELETE FROM sessions
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteUserSessions(ctx context.Context, userID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteUserSessions, userID)
+	return err
+}
+
+const getAddressLoginAttempts = `-- name: GetAddressLoginAttempts :one
+SELECT address, failures, last_failure_at, locked_until FROM address_login_attempts
+WHERE address = $1 LIMIT 1
+`
+
+func (q *Queries) GetAddressLoginAttempts(ctx context.Context, address string) (AddressLoginAttempt, error) {
+	row := q.db.QueryRowContext(ctx, getAddressLoginAttempts, address)
+	var i AddressLoginAttempt
+	err := row.Scan(
+		&i.Address,
+		&i.Failures,
+		&i.LastFailureAt,
+		&i.LockedUntil,
+	)
+	return i, err
+}
+
+const getLoginAttempts = `-- name: GetLoginAttempts :one
+SELECT username, failures, last_failure_at, locked_until FROM login_attempts
+WHERE username = $1 LIMIT 1
+`
+
+func (q *Queries) GetLoginAttempts(ctx context.Context, username string) (LoginAttempt, error) {
+	row := q.db.QueryRowContext(ctx, getLoginAttempts, username)
+	var i LoginAttempt
+	err := row.Scan(
+		&i.Username,
+		&i.Failures,
+		&i.LastFailureAt,
+		&i.LockedUntil,
+	)
+	return i, err
+}
+
 const getPlayerByName = `-- name: GetPlayerByName :one
-SELECT id, user_id, name, best_score, color FROM players
+SELECT id, user_id, name, best_score, color, name_skeleton FROM players
 WHERE name ILIKE $1
 LIMIT 1
 `
@@ -73,12 +320,33 @@ func (q *Queries) GetPlayerByName(ctx context.Context, name string) (Player, err
 		&i.Name,
 		&i.BestScore,
 		&i.Color,
+		&i.NameSkeleton,
+	)
+	return i, err
+}
+
+const getPlayerByNameSkeleton = `-- name: GetPlayerByNameSkeleton :one
+SELECT id, user_id, name, best_score, color, name_skeleton FROM players
+WHERE name_skeleton = $1
+LIMIT 1
+`
+
+func (q *Queries) GetPlayerByNameSkeleton(ctx context.Context, nameSkeleton sql.NullString) (Player, error) {
+	row := q.db.QueryRowContext(ctx, getPlayerByNameSkeleton, nameSkeleton)
+	var i Player
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.BestScore,
+		&i.Color,
+		&i.NameSkeleton,
 	)
 	return i, err
 }
 
 const getPlayerByUserID = `-- name: GetPlayerByUserID :one
-SELECT id, user_id, name, best_score, color FROM players
+SELECT id, user_id, name, best_score, color, name_skeleton FROM players
 WHERE user_id = $1 LIMIT 1
 `
 
@@ -91,10 +359,45 @@ func (q *Queries) GetPlayerByUserID(ctx context.Context, userID int32) (Player,
 		&i.Name,
 		&i.BestScore,
 		&i.Color,
+		&i.NameSkeleton,
 	)
 	return i, err
 }
 
+const getPlayersWithoutNameSkeleton = `-- name: GetPlayersWithoutNameSkeleton :many
+SELECT id, name FROM players
+WHERE name_skeleton IS NULL
+ORDER BY id
+`
+
+type GetPlayersWithoutNameSkeletonRow struct {
+	ID   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+func (q *Queries) GetPlayersWithoutNameSkeleton(ctx context.Context) ([]GetPlayersWithoutNameSkeletonRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPlayersWithoutNameSkeleton)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPlayersWithoutNameSkeletonRow
+	for rows.Next() {
+		var i GetPlayersWithoutNameSkeletonRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPlayerRank = `-- name: GetPlayerRank :one
 SELECT COUNT(*) + 1 AS rank FROM players
 WHERE best_score > (
@@ -110,6 +413,24 @@ func (q *Queries) GetPlayerRank(ctx context.Context, id int32) (int32, error) {
 	return rank, err
 }
 
+const getSession = `-- name: GetSession :one
+SELECT id, user_id, created_at, expires_at FROM sessions
+WHERE id = $1 AND expires_at > NOW()
+LIMIT 1
+`
+
+func (q *Queries) GetSession(ctx context.Context, id string) (Session, error) {
+	row := q.db.QueryRowContext(ctx, getSession, id)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
 const getTopScores = `-- name: GetTopScores :many
 SELECT name, best_score
 FROM players
@@ -151,6 +472,18 @@ func (q *Queries) GetTopScores(ctx context.Context, arg GetTopScoresParams) ([]G
 	return items, nil
 }
 
+const getUserByID = `-- name: GetUserByID :one
+SELECT id, username, password_hash FROM users
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetUserByID(ctx context.Context, id int32) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByID, id)
+	var i User
+	err := row.Scan(&i.ID, &i.Username, &i.PasswordHash)
+	return i, err
+}
+
 const getUserByUsername = `-- name: GetUserByUsername :one
 SELECT id, username, password_hash FROM users
 WHERE username = $1 LIMIT 1
@@ -163,6 +496,38 @@ func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User,
 	return i, err
 }
 
+const lockAddressLoginAttempts = `-- name: LockAddressLoginAttempts :exec
+UPDATE address_login_attempts
+SET locked_until = GREATEST(locked_until, $2)
+WHERE address = $1
+`
+
+type LockAddressLoginAttemptsParams struct {
+	Address     string    `json:"address"`
+	LockedUntil time.Time `json:"locked_until"`
+}
+
+func (q *Queries) LockAddressLoginAttempts(ctx context.Context, arg LockAddressLoginAttemptsParams) error {
+	_, err := q.db.ExecContext(ctx, lockAddressLoginAttempts, arg.Address, arg.LockedUntil)
+	return err
+}
+
+const lockLoginAttempts = `-- name: LockLoginAttempts :exec
+UPDATE login_attempts
+SET locked_until = GREATEST(locked_until, $2)
+WHERE username = $1
+`
+
+type LockLoginAttemptsParams struct {
+	Username    string    `json:"username"`
+	LockedUntil time.Time `json:"locked_until"`
+}
+
+func (q *Queries) LockLoginAttempts(ctx context.Context, arg LockLoginAttemptsParams) error {
+	_, err := q.db.ExecContext(ctx, lockLoginAttempts, arg.Username, arg.LockedUntil)
+	return err
+}
+
 const updatePlayerBestScore = `-- name: UpdatePlayerBestScore :exec
 UPDATE players
 SET best_score = $1
@@ -178,3 +543,35 @@ func (q *Queries) UpdatePlayerBestScore(ctx context.Context, arg UpdatePlayerBes
 	_, err := q.db.ExecContext(ctx, updatePlayerBestScore, arg.BestScore, arg.ID)
 	return err
 }
+
+const updatePlayerNameSkeleton = `-- name: UpdatePlayerNameSkeleton :exec
+UPDATE players
+SET name_skeleton = $1
+WHERE id = $2
+`
+
+type UpdatePlayerNameSkeletonParams struct {
+	NameSkeleton sql.NullString `json:"name_skeleton"`
+	ID           int32          `json:"id"`
+}
+
+func (q *Queries) UpdatePlayerNameSkeleton(ctx context.Context, arg UpdatePlayerNameSkeletonParams) error {
+	_, err := q.db.ExecContext(ctx, updatePlayerNameSkeleton, arg.NameSkeleton, arg.ID)
+	return err
+}
+
+const updateUserPassword = `-- name: UpdateUserPassword :exec
+UPDATE users
+SET password_hash = $1
+WHERE id = $2
+`
+
+type UpdateUserPasswordParams struct {
+	PasswordHash string `json:"password_hash"`
+	ID           int32  `json:"id"`
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserPassword, arg.PasswordHash, arg.ID)
+	return err
+}
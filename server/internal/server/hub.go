@@ -5,8 +5,8 @@ import (
 	"database/sql"
 	_ "embed"
 	"log"
-	"math/rand/v2"
 	"net/http"
+	"server/internal/server/auth"
 	"server/internal/server/db"
 	"server/internal/server/objects"
 	"server/pkg/packets"
@@ -15,51 +15,17 @@ import (
 	_ "github.com/jackc/pgx/v5/stdlib"
 )
 
-func (h *Hub) replenishSporesLoop(rate time.Duration) {
-	ticker := time.NewTicker(rate)
-	defer ticker.Stop()
-
-	for range ticker.C {
-		sporesRemaining := h.SharedGameObjects.Spores.Len()
-		diff := MaxSpores - sporesRemaining
-
-		if diff <= 0 {
-			continue
-		}
-
-		log.Printf("%d spores remain - going to replenish %d spores", sporesRemaining, diff)
-
-		for i := 0; i < min(diff, 10); i++ {
-			spore := h.newSpore()
-			sporeId := h.SharedGameObjects.Spores.Add(spore)
-
-			packet := &packets.Packet{
-				SenderId: 0,
-				Msg:      packets.NewSpore(sporeId, spore),
-			}
-			select {
-			case h.BroadcastChan <- packet:
-			default:
-				log.Printf("BroadcastChan full, dropping spore spawn notification for spore %d", sporeId)
-			}
-
-			time.Sleep(50 * time.Millisecond)
-		}
-	}
-}
-
-func (h *Hub) newSpore() *objects.Spore {
-	sporeRadius := max(rand.NormFloat64()*3+10, 5)
-	x, y := objects.SpawnCoords(sporeRadius, h.SharedGameObjects.Players, h.SharedGameObjects.Spores)
-	return &objects.Spore{X: x, Y: y, Radius: sporeRadius}
-}
-
 // Embed the database schema
 //
 //go:embed db/config/schema.sql
 var schemaGenSql string
 
-const MaxSpores int = 1000
+// Number of spores, viruses and power-ups in a standard-sized room
+const (
+	MaxSpores   int = 1000
+	MaxViruses  int = 20
+	MaxPowerUps int = 10
+)
 
 type DbTx struct {
 	Ctx     context.Context
@@ -73,9 +39,13 @@ func (h *Hub) NewDbTx() *DbTx {
 	}
 }
 
+// The players, spores, viruses and power-ups in a room. They are spatially indexed so proximity queries
+// (spawning, consumption, areas of interest) don't have to scan the whole world
 type SharedGameObjects struct {
-	Players *objects.SharedCollection[*objects.Player]
-	Spores  *objects.SharedCollection[*objects.Spore]
+	Players  *objects.SpatialCollection[*objects.Player]
+	Spores   *objects.SpatialCollection[*objects.Spore]
+	Viruses  *objects.SpatialCollection[*objects.Virus]
+	PowerUps *objects.SpatialCollection[*objects.PowerUp]
 }
 
 // Structure for connected client to interface with the hub
@@ -111,13 +81,49 @@ type ClientInterfacer interface {
 
 	DbTx() *DbTx
 
+	// Objects in the client's current room, or nil if it isn't in one
 	SharedGameObjects() *SharedGameObjects
+
+	// All rooms on the server
+	Rooms() *objects.SharedCollection[*Room]
+
+	// Issues and checks the tokens clients log back in with
+	Tokens() *auth.TokenSigner
+
+	// Keeps track of failed logins so password guessers get locked out
+	LoginGuard() *auth.LoginGuard
+
+	// Where the client is connecting from, or empty if it isn't connecting over the network
+	RemoteAddress() string
+
+	// The room the client is in, or nil if it hasn't joined one
+	Room() *Room
+
+	// Moves the client into the room to play as the player, leaving any room it was already in
+	JoinRoom(room *Room, player *objects.Player) error
+
+	// Opens a room which only players with its code can join, owned by this client
+	OpenPrivateRoom(config RoomConfig) *Room
+
+	// Moves the client into the room as a spectator, leaving any room it was already in
+	WatchRoom(room *Room)
+
+	// Takes the client out of its current room, if any
+	LeaveRoom()
+
+	// Lets a later connection presenting the token take over this client if its connection drops
+	SetResumeToken(token string)
+
+	// Takes over the client whose connection dropped with the token, along with its ID, room and
+	// state. Returns false if no such client is waiting to be resumed.
+	Resume(token string) bool
 }
 
 type Hub struct {
 	Clients *objects.SharedCollection[ClientInterfacer]
 
-	// Packets in this channel will be processed by all connected clients except the sender
+	// Packets in this channel will be processed by all connected clients except the sender. Clients
+	// in a room broadcast through the room instead.
 	BroadcastChan chan *packets.Packet
 
 	// Clients in this channel will be registered with the hub
@@ -129,7 +135,11 @@ type Hub struct {
 	// Database connection pool
 	dbPool *sql.DB
 
-	SharedGameObjects *SharedGameObjects
+	Rooms *objects.SharedCollection[*Room]
+
+	Tokens *auth.TokenSigner
+
+	LoginGuard *auth.LoginGuard
 }
 
 // State machine to process the client's messages
@@ -144,7 +154,16 @@ type ClientStateHandler interface {
 	OnExit()
 }
 
-func NewHub(databaseURL string) *Hub {
+// States which outlive a dropped connection for a while, keeping the client's place until it is
+// resumed or given up on
+type Resumable interface {
+	OnConnectionLost()
+	OnConnectionResumed()
+}
+
+// Session tokens are signed with sessionKey, so logins are only remembered across restarts if it
+// stays the same
+func NewHub(databaseURL string, sessionKey []byte) *Hub {
 	dbPool, err := sql.Open("pgx", databaseURL)
 	if err != nil {
 		log.Fatal("Failed to open database connection:", err)
@@ -156,8 +175,8 @@ func NewHub(databaseURL string) *Hub {
 	}
 
 	// Configure PostgreSQL connection pool
-	dbPool.SetMaxOpenConns(25)      // Maximum number of open connections
-	dbPool.SetMaxIdleConns(5)       // Maximum number of idle connections
+	dbPool.SetMaxOpenConns(25)                 // Maximum number of open connections
+	dbPool.SetMaxIdleConns(5)                  // Maximum number of idle connections
 	dbPool.SetConnMaxLifetime(5 * time.Minute) // Maximum connection lifetime
 
 	log.Println("Successfully connected to PostgreSQL database")
@@ -168,10 +187,37 @@ func NewHub(databaseURL string) *Hub {
 		RegisterChan:   make(chan ClientInterfacer, 100),
 		UnregisterChan: make(chan ClientInterfacer, 100),
 		dbPool:         dbPool,
-		SharedGameObjects: &SharedGameObjects{
-			Players: objects.NewSharedCollection[*objects.Player](),
-			Spores:  objects.NewSharedCollection[*objects.Spore](),
-		},
+		Rooms:          objects.NewSharedCollection[*Room](),
+		Tokens:         auth.NewTokenSigner(sessionKey),
+		LoginGuard:     auth.NewLoginGuard(db.New(dbPool)),
+	}
+}
+
+// Works out what the names of players registered before skeletons were stored look like, so new
+// names can't pass for them either. Where two old names already look alike, the one registered
+// first keeps its skeleton.
+func (h *Hub) backfillNameSkeletons() {
+	ctx := context.Background()
+	queries := db.New(h.dbPool)
+	players, err := queries.GetPlayersWithoutNameSkeleton(ctx)
+	if err != nil {
+		log.Printf("Error getting players without name skeletons: %v", err)
+		return
+	}
+
+	for _, player := range players {
+		err := queries.UpdatePlayerNameSkeleton(ctx, db.UpdatePlayerNameSkeletonParams{
+			NameSkeleton: sql.NullString{String: auth.Skeleton(player.Name), Valid: true},
+			ID:           player.ID,
+		})
+		if db.IsUniqueViolation(err, db.PlayerNameSkeletonIndex) {
+			log.Printf("Player %d's name %s looks like an older player's, so is left without a skeleton", player.ID, player.Name)
+		} else if err != nil {
+			log.Printf("Error updating name skeleton of player %d: %v", player.ID, err)
+		}
+	}
+	if len(players) > 0 {
+		log.Printf("Stored name skeletons for %d players", len(players))
 	}
 }
 
@@ -180,25 +226,34 @@ func (h *Hub) Run() {
 	if _, err := h.dbPool.ExecContext(context.Background(), schemaGenSql); err != nil {
 		log.Fatal(err)
 	}
-
-	log.Println("Placing spores...")
-	for i := 0; i < MaxSpores; i++ {
-		h.SharedGameObjects.Spores.Add(h.newSpore())
+	if err := db.New(h.dbPool).DeleteExpiredSessions(context.Background()); err != nil {
+		log.Printf("Error clearing out expired sessions: %v", err)
+	}
+	if err := h.LoginGuard.Sweep(context.Background()); err != nil {
+		log.Printf("Error clearing out old failed logins: %v", err)
 	}
+	h.backfillNameSkeletons()
 
-	go h.replenishSporesLoop(2 * time.Second)
+	log.Println("Opening rooms...")
+	for _, config := range DefaultRooms {
+		h.OpenRoom(config)
+	}
 
 	log.Println("Awaiting client registrations")
 
 	// Start monitoring goroutine for channel health
 	go h.monitorChannelHealth()
+	go h.reapRoomsLoop(10 * time.Second)
 
 	for {
 		select {
 		case client := <-h.RegisterChan:
 			client.Initialize(h.Clients.Add(client))
 		case client := <-h.UnregisterChan:
-			h.Clients.Remove(client.Id())
+			// A resumed client takes the ID over from the one it replaced
+			if registered, exists := h.Clients.Get(client.Id()); exists && registered == client {
+				h.Clients.Remove(client.Id())
+			}
 		case packet := <-h.BroadcastChan:
 			h.Clients.ForEach(func(clientId uint64, client ClientInterfacer) {
 				if clientId != packet.SenderId {
@@ -209,6 +264,18 @@ func (h *Hub) Run() {
 	}
 }
 
+// Creates a room and starts its simulation
+func (h *Hub) OpenRoom(config RoomConfig) *Room {
+	return h.startRoom(NewRoom(config))
+}
+
+func (h *Hub) startRoom(room *Room) *Room {
+	room.dbTx = h.NewDbTx()
+	room.Id = h.Rooms.Add(room)
+	go room.Run()
+	return room
+}
+
 func (h *Hub) monitorChannelHealth() {
 	ticker := time.NewTicker(10 * time.Second)
 	defer ticker.Stop()
@@ -221,7 +288,7 @@ func (h *Hub) monitorChannelHealth() {
 		if broadcastLen > 1500 {
 			log.Printf("WARNING: BroadcastChan is %d/2000 (%.1f%% full)", broadcastLen, float64(broadcastLen)/20.0)
 		}
-		if registerLen > 75 {=
+		if registerLen > 75 {
 			log.Printf("WARNING: RegisterChan is %d/100 full", registerLen)
 		}
 		if unregisterLen > 75 {
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"cmp"
+	"math"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"slices"
+	"time"
+)
+
+// Number of players shown on the in-match leaderboard
+const LeaderboardSize = 10
+
+// Broadcasts every player's current rank at a fixed rate, along with the team scores in rooms
+// played in teams. Each client trims the full ranking down to the top players and its own entry
+// before sending it on.
+func (r *Room) leaderboardLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+		}
+
+		ranking := r.Ranking()
+		if len(ranking) == 0 {
+			continue
+		}
+
+		packet := &packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewLeaderboard(ranking, nil),
+		}
+		select {
+		case r.BroadcastChan <- packet:
+		default:
+			r.logger.Println("BroadcastChan full, dropping leaderboard update")
+		}
+
+		if r.Teams <= 0 {
+			continue
+		}
+		packet = &packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewTeamScores(r.TeamScores()),
+		}
+		select {
+		case r.BroadcastChan <- packet:
+		default:
+			r.logger.Println("BroadcastChan full, dropping team scores update")
+		}
+	}
+}
+
+// Every player in the room ordered by current mass, biggest first. Ties go to the lowest ID.
+func (r *Room) Ranking() []*packets.LeaderboardEntryMessage {
+	var ranking []*packets.LeaderboardEntryMessage
+	r.SharedGameObjects.Players.ForEach(func(playerId uint64, player *objects.Player) {
+		ranking = append(ranking, &packets.LeaderboardEntryMessage{
+			PlayerId: playerId,
+			Name:     player.Name,
+			Score:    uint64(math.Round(player.Mass())),
+		})
+	})
+
+	slices.SortFunc(ranking, func(a, b *packets.LeaderboardEntryMessage) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.PlayerId, b.PlayerId)
+	})
+	for i, entry := range ranking {
+		entry.Rank = uint64(i + 1)
+	}
+	return ranking
+}
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"server/internal/server/objects"
+	"testing"
+)
+
+// TestRanking tests ordering players for the in-match leaderboard
+func TestRanking(t *testing.T) {
+	t.Run("Players are ranked by total mass", func(t *testing.T) {
+		room := testRoom()
+		room.SharedGameObjects.Players.Add(&objects.Player{Name: "Small", Radius: 20}, 1)
+		room.SharedGameObjects.Players.Add(&objects.Player{Name: "Big", Radius: 50}, 2)
+		room.SharedGameObjects.Players.Add(&objects.Player{Name: "Split", Radius: 30, Cells: []*objects.Cell{{Radius: 30}}}, 3)
+
+		ranking := room.Ranking()
+
+		if len(ranking) != 3 {
+			t.Fatalf("Expected 3 entries, got %d", len(ranking))
+		}
+		for i, expected := range []string{"Big", "Split", "Small"} {
+			if ranking[i].Name != expected || ranking[i].Rank != uint64(i+1) {
+				t.Errorf("Expected %s at rank %d, got %s at rank %d", expected, i+1, ranking[i].Name, ranking[i].Rank)
+			}
+		}
+	})
+
+	t.Run("Ties go to the lowest ID", func(t *testing.T) {
+		room := testRoom()
+		room.SharedGameObjects.Players.Add(&objects.Player{Radius: 20}, 9)
+		room.SharedGameObjects.Players.Add(&objects.Player{Radius: 20}, 4)
+
+		if ranking := room.Ranking(); ranking[0].PlayerId != 4 {
+			t.Errorf("Expected player 4 to rank first, got %d", ranking[0].PlayerId)
+		}
+	})
+}
@@ -0,0 +1,214 @@
+package objects
+
+import (
+	"math"
+	"time"
+)
+
+// Most cells a single player can be split into
+const MaxCells = 16
+
+// Split-off cells fly out this fast, and must wait this long before merging back
+const (
+	SplitSpeed float64 = 500
+	MergeDelay         = 15 * time.Second
+)
+
+// Split cells are pulled back towards the main body at this fraction of their distance per second
+const cellCohesion float64 = 2.0
+
+// A piece of a player that has split off from its main body
+type Cell struct {
+	X      float64
+	Y      float64
+	Radius float64
+
+	// Boost from splitting off, which fades like a sliding spore's
+	VelocityX float64
+	VelocityY float64
+
+	// When the cell may merge back into the main body
+	MergeAt time.Time
+}
+
+// The player's main body as a cell. Changes to it only take effect once passed to setBody.
+func (p *Player) body() *Cell {
+	return &Cell{X: p.X, Y: p.Y, Radius: p.Radius}
+}
+
+func (p *Player) setBody(body *Cell) {
+	p.X, p.Y, p.Radius = body.X, body.Y, body.Radius
+}
+
+// Calls the callback with the player's main body followed by each of its split cells. Changes the
+// callback makes to the main body are written back to the player.
+func (p *Player) ForEachCell(callback func(*Cell)) {
+	body := p.body()
+	callback(body)
+	p.setBody(body)
+
+	for _, cell := range p.Cells {
+		callback(cell)
+	}
+}
+
+// Combined mass of all the player's cells
+func (p *Player) Mass() float64 {
+	mass := 0.0
+	p.ForEachCell(func(cell *Cell) {
+		mass += RadToMass(cell.Radius)
+	})
+	return mass
+}
+
+// Distance from the center of the main body to the furthest edge of any of the player's cells
+func (p *Player) Reach() float64 {
+	reach := p.Radius
+	for _, cell := range p.Cells {
+		reach = max(reach, math.Hypot(cell.X-p.X, cell.Y-p.Y)+cell.Radius)
+	}
+	return reach
+}
+
+// Splits every cell big enough to leave two halves of at least spawn size, firing the new halves
+// off in the player's direction. Returns false if no cell could split.
+func (p *Player) Split(now time.Time) bool {
+	dirX, dirY := math.Cos(p.Direction), math.Sin(p.Direction)
+	minSplitMass := 2 * RadToMass(SpawnRadius)
+
+	var newCells []*Cell
+	p.ForEachCell(func(cell *Cell) {
+		if 1+len(p.Cells)+len(newCells) >= MaxCells || RadToMass(cell.Radius) < minSplitMass {
+			return
+		}
+
+		cell.Radius = MassToRad(RadToMass(cell.Radius) / 2)
+		newCells = append(newCells, &Cell{
+			X:         cell.X + dirX*cell.Radius,
+			Y:         cell.Y + dirY*cell.Radius,
+			Radius:    cell.Radius,
+			VelocityX: dirX * SplitSpeed,
+			VelocityY: dirY * SplitSpeed,
+			MergeAt:   now.Add(MergeDelay),
+		})
+	})
+
+	if len(newCells) == 0 {
+		return false
+	}
+	p.Cells = append(p.Cells, newCells...)
+	return true
+}
+
+// Shatters the given cell into equal pieces flying off in every direction, as many as the cell
+// limit allows up to the given number. Returns false if there is no room for any more cells.
+func (p *Player) Explode(cell *Cell, pieces int, now time.Time) bool {
+	pieces = min(pieces, MaxCells-len(p.Cells))
+	if pieces < 2 {
+		return false
+	}
+
+	cell.Radius = MassToRad(RadToMass(cell.Radius) / float64(pieces))
+	cell.MergeAt = now.Add(MergeDelay)
+	for i := 1; i < pieces; i++ {
+		angle := 2 * math.Pi * float64(i) / float64(pieces)
+		dirX, dirY := math.Cos(angle), math.Sin(angle)
+		p.Cells = append(p.Cells, &Cell{
+			X:         cell.X + dirX*cell.Radius,
+			Y:         cell.Y + dirY*cell.Radius,
+			Radius:    cell.Radius,
+			VelocityX: dirX * SplitSpeed,
+			VelocityY: dirY * SplitSpeed,
+			MergeAt:   now.Add(MergeDelay),
+		})
+	}
+	return true
+}
+
+// Pushes apart cells which are still waiting to merge, and merges the rest into the main body once
+// they overlap its center. Returns whether any cells merged.
+func (p *Player) SettleCells(now time.Time) bool {
+	merged := false
+	remaining := p.Cells[:0]
+	for _, cell := range p.Cells {
+		if !now.Before(cell.MergeAt) && math.Hypot(cell.X-p.X, cell.Y-p.Y) < p.Radius {
+			p.Radius = MassToRad(RadToMass(p.Radius) + RadToMass(cell.Radius))
+			merged = true
+			continue
+		}
+		remaining = append(remaining, cell)
+	}
+	clear(p.Cells[len(remaining):])
+	p.Cells = remaining
+
+	body := p.body()
+	cells := append([]*Cell{body}, p.Cells...)
+	for i, a := range cells {
+		for _, b := range cells[i+1:] {
+			if now.Before(a.MergeAt) || now.Before(b.MergeAt) {
+				separate(a, b)
+			}
+		}
+	}
+	p.setBody(body)
+
+	return merged
+}
+
+// Removes the cells for which eaten returns true, promoting the biggest remaining split cell to be
+// the main body if the main body itself was eaten. Returns the mass removed and whether the player
+// has any cells left.
+func (p *Player) RemoveCells(eaten func(*Cell) bool) (float64, bool) {
+	massRemoved := 0.0
+	body := p.body()
+	bodyEaten := eaten(body)
+	if bodyEaten {
+		massRemoved += RadToMass(body.Radius)
+	}
+
+	var survivors []*Cell
+	for _, cell := range p.Cells {
+		if eaten(cell) {
+			massRemoved += RadToMass(cell.Radius)
+		} else {
+			survivors = append(survivors, cell)
+		}
+	}
+	p.Cells = survivors
+
+	if !bodyEaten {
+		return massRemoved, true
+	}
+	if len(survivors) == 0 {
+		return massRemoved, false
+	}
+
+	biggest := 0
+	for i, cell := range survivors {
+		if cell.Radius > survivors[biggest].Radius {
+			biggest = i
+		}
+	}
+	p.setBody(survivors[biggest])
+	p.Cells = append(survivors[:biggest], survivors[biggest+1:]...)
+	return massRemoved, true
+}
+
+// Pushes two overlapping cells apart by equal amounts
+func separate(a, b *Cell) {
+	dx, dy := b.X-a.X, b.Y-a.Y
+	distance := math.Hypot(dx, dy)
+	overlap := a.Radius + b.Radius - distance
+	if overlap <= 0 {
+		return
+	}
+	if distance == 0 {
+		dx, dy, distance = 1, 0, 1
+	}
+
+	pushX, pushY := dx/distance*overlap/2, dy/distance*overlap/2
+	a.X -= pushX
+	a.Y -= pushY
+	b.X += pushX
+	b.Y += pushY
+}
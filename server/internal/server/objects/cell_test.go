@@ -0,0 +1,105 @@
+package objects
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestSplit tests players splitting into multiple cells
+func TestSplit(t *testing.T) {
+	t.Run("Splitting halves the mass between two cells", func(t *testing.T) {
+		player := &Player{Radius: 50}
+		massBefore := player.Mass()
+
+		if !player.Split(time.Now()) {
+			t.Fatal("Expected a big player to be able to split")
+		}
+		if len(player.Cells) != 1 {
+			t.Fatalf("Expected one split cell, got %d", len(player.Cells))
+		}
+		if math.Abs(player.Radius-player.Cells[0].Radius) > 0.0001 {
+			t.Errorf("Expected equal halves, got radii %f and %f", player.Radius, player.Cells[0].Radius)
+		}
+		if math.Abs(player.Mass()-massBefore) > 0.0001 {
+			t.Errorf("Total mass %f changed from %f after splitting", player.Mass(), massBefore)
+		}
+	})
+
+	t.Run("Small players cannot split", func(t *testing.T) {
+		player := &Player{Radius: SpawnRadius}
+
+		if player.Split(time.Now()) {
+			t.Error("Player at spawn size should not be able to split")
+		}
+	})
+
+	t.Run("Players cannot split past the cell limit", func(t *testing.T) {
+		player := &Player{Radius: 1000}
+		for range 10 {
+			player.Split(time.Now())
+		}
+
+		if cells := 1 + len(player.Cells); cells != MaxCells {
+			t.Errorf("Expected %d cells, got %d", MaxCells, cells)
+		}
+	})
+}
+
+// TestSettleCells tests split cells pushing apart and merging back together
+func TestSettleCells(t *testing.T) {
+	t.Run("Cells waiting to merge are pushed apart", func(t *testing.T) {
+		now := time.Now()
+		player := &Player{Radius: 20, Cells: []*Cell{{X: 10, Radius: 20, MergeAt: now.Add(time.Minute)}}}
+
+		if player.SettleCells(now) {
+			t.Error("Cell should not merge before its merge time")
+		}
+		if distance := player.Cells[0].X - player.X; distance < 40-0.0001 {
+			t.Errorf("Cells should no longer overlap, but are only %f apart", distance)
+		}
+	})
+
+	t.Run("Cells merge once their time is up", func(t *testing.T) {
+		now := time.Now()
+		player := &Player{Radius: 20, Cells: []*Cell{{X: 10, Radius: 20, MergeAt: now.Add(-time.Second)}}}
+		massBefore := player.Mass()
+
+		if !player.SettleCells(now) {
+			t.Fatal("Expected the cell to merge into the main body")
+		}
+		if len(player.Cells) != 0 {
+			t.Errorf("Expected no split cells left, got %d", len(player.Cells))
+		}
+		if math.Abs(player.Mass()-massBefore) > 0.0001 {
+			t.Errorf("Total mass %f changed from %f after merging", player.Mass(), massBefore)
+		}
+	})
+}
+
+// TestRemoveCells tests other players eating individual cells
+func TestRemoveCells(t *testing.T) {
+	t.Run("Biggest cell takes over when the main body is eaten", func(t *testing.T) {
+		player := &Player{Radius: 10, Cells: []*Cell{{X: 100, Radius: 15}, {X: 200, Radius: 30}}}
+
+		mass, survived := player.RemoveCells(func(cell *Cell) bool { return cell.X == 0 })
+
+		if !survived {
+			t.Fatal("Player with cells left should survive")
+		}
+		if math.Abs(mass-RadToMass(10)) > 0.0001 {
+			t.Errorf("Expected the main body's mass to be removed, got %f", mass)
+		}
+		if player.X != 200 || player.Radius != 30 || len(player.Cells) != 1 {
+			t.Errorf("Expected the biggest cell to become the main body, got %+v", player)
+		}
+	})
+
+	t.Run("Player with every cell eaten does not survive", func(t *testing.T) {
+		player := &Player{Radius: 10, Cells: []*Cell{{X: 100, Radius: 15}}}
+
+		if _, survived := player.RemoveCells(func(*Cell) bool { return true }); survived {
+			t.Error("Player with no cells left should not survive")
+		}
+	})
+}
@@ -1,6 +1,9 @@
 package objects
 
-import "time"
+import (
+	"sync/atomic"
+	"time"
+)
 
 type Player struct {
 	Name      string
@@ -12,6 +15,35 @@ type Player struct {
 	DbId      int32
 	BestScore int32
 	Color     int32
+	IsBot     bool
+
+	// The account the player logged in to, and the remembered session it logged in with if any
+	UserId    int32
+	SessionId string
+
+	// Playing without an account, so nothing about the player is saved
+	Guest bool
+
+	// Teammates can't eat each other. 0 means the player is on nobody's side.
+	Team int
+
+	// Cells split off from the main body at X, Y with Radius
+	Cells []*Cell
+
+	// Most mass the player has had since spawning, since decay shrinks it over time
+	PeakMass float64
+
+	// When each power-up the player is benefiting from wears off
+	PowerUps map[PowerUpKind]time.Time
+
+	// Intents queued by the player's client for the next world step, and the direction it last
+	// steered towards
+	intents  atomic.Uint32
+	steering atomic.Uint64
+
+	// Set while the player's connection is down, so it waits where it is to be resumed. Written by
+	// the client and read by the world tick, so it is kept out of the tick-owned fields above.
+	frozen atomic.Bool
 }
 
 type Spore struct {
@@ -20,4 +52,35 @@ type Spore struct {
 	Radius    float64
 	DroppedBy *Player
 	DroppedAt time.Time
+
+	// Ejected spores slide along until friction brings them to rest
+	VelocityX float64
+	VelocityY float64
 }
+
+// A spiky obstacle which shatters cells big enough to swallow it
+type Virus struct {
+	X      float64
+	Y      float64
+	Radius float64
+}
+
+// A pickup which grants whoever touches it a temporary power-up
+type PowerUp struct {
+	X      float64
+	Y      float64
+	Radius float64
+	Kind   PowerUpKind
+}
+
+func (p *Player) Position() (float64, float64) { return p.X, p.Y }
+func (p *Player) Size() float64                { return p.Reach() }
+
+func (s *Spore) Position() (float64, float64) { return s.X, s.Y }
+func (s *Spore) Size() float64                { return s.Radius }
+
+func (v *Virus) Position() (float64, float64) { return v.X, v.Y }
+func (v *Virus) Size() float64                { return v.Radius }
+
+func (p *PowerUp) Position() (float64, float64) { return p.X, p.Y }
+func (p *PowerUp) Size() float64                { return p.Radius }
@@ -0,0 +1,47 @@
+package objects
+
+import "math"
+
+// Something a player's client asks for which changes its cells. Only the world tick may touch a
+// player's cells, so clients queue these for the next world step to carry out.
+type Intent uint32
+
+const (
+	IntentEjectMass Intent = 1 << iota
+	IntentSplit
+	IntentSteer
+)
+
+func (i Intent) Has(intent Intent) bool {
+	return i&intent != 0
+}
+
+// Asks the next world step to carry out the intent. Asking again before then makes no difference.
+func (p *Player) Queue(intent Intent) {
+	p.intents.Or(uint32(intent))
+}
+
+// Clears and returns everything queued since the last call
+func (p *Player) TakeIntents() Intent {
+	return Intent(p.intents.Swap(0))
+}
+
+// Asks the next world step to turn the player to face the direction, in radians
+func (p *Player) Steer(direction float64) {
+	p.steering.Store(math.Float64bits(direction))
+	p.Queue(IntentSteer)
+}
+
+// The direction most recently asked for with Steer
+func (p *Player) Steering() float64 {
+	return math.Float64frombits(p.steering.Load())
+}
+
+// Whether the player is waiting where it is for its connection to come back
+func (p *Player) Frozen() bool {
+	return p.frozen.Load()
+}
+
+func (p *Player) SetFrozen(frozen bool) {
+	p.frozen.Store(frozen)
+}
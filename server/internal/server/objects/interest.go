@@ -0,0 +1,94 @@
+package objects
+
+import "sync"
+
+// The client renders a 1280x720 viewport and lets the player zoom out to 2*SpawnRadius/radius, so
+// it can see at most this many world units either side of its center per unit of player radius
+const (
+	viewportHalfWidthPerRadius  float64 = 640 / (2 * SpawnRadius)
+	viewportHalfHeightPerRadius float64 = 360 / (2 * SpawnRadius)
+)
+
+// Extra space around the visible area so objects are known to the client just before they scroll
+// into view
+const ViewportMargin float64 = 200
+
+// Axis-aligned rectangle of the world a client is interested in
+type Viewport struct {
+	MinX float64
+	MaxX float64
+	MinY float64
+	MaxY float64
+}
+
+// Area of the world the player's client can see, plus a margin
+func ViewportFor(player *Player) Viewport {
+	halfWidth := player.Radius*viewportHalfWidthPerRadius + ViewportMargin
+	halfHeight := player.Radius*viewportHalfHeightPerRadius + ViewportMargin
+	return Viewport{
+		MinX: player.X - halfWidth,
+		MaxX: player.X + halfWidth,
+		MinY: player.Y - halfHeight,
+		MaxY: player.Y + halfHeight,
+	}
+}
+
+// Whether any part of a circle lies within the viewport
+func (v Viewport) Contains(x, y, radius float64) bool {
+	return x+radius >= v.MinX && x-radius <= v.MaxX && y+radius >= v.MinY && y-radius <= v.MaxY
+}
+
+// A thread-safe set of object IDs a client has been told about
+type InterestSet struct {
+	known map[uint64]struct{}
+	mux   sync.Mutex
+}
+
+func NewInterestSet() *InterestSet {
+	return &InterestSet{
+		known: make(map[uint64]struct{}),
+	}
+}
+
+func (s *InterestSet) Has(id uint64) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	_, ok := s.known[id]
+	return ok
+}
+
+func (s *InterestSet) Add(id uint64) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.known[id] = struct{}{}
+}
+
+func (s *InterestSet) Remove(id uint64) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	delete(s.known, id)
+}
+
+// Replaces the known set with the visible set, returning the IDs which entered and left it. The set
+// takes ownership of the visible map.
+func (s *InterestSet) Update(visible map[uint64]struct{}) (entered []uint64, left []uint64) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for id := range visible {
+		if _, ok := s.known[id]; !ok {
+			entered = append(entered, id)
+		}
+	}
+	for id := range s.known {
+		if _, ok := visible[id]; !ok {
+			left = append(left, id)
+		}
+	}
+
+	s.known = visible
+	return entered, left
+}
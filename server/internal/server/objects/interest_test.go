@@ -0,0 +1,78 @@
+package objects
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestViewport tests which objects fall within a player's area of interest
+func TestViewport(t *testing.T) {
+	player := &Player{X: 0, Y: 0, Radius: SpawnRadius}
+	viewport := ViewportFor(player)
+
+	t.Run("Object at player's position is visible", func(t *testing.T) {
+		if !viewport.Contains(0, 0, 5) {
+			t.Error("Object on top of the player should be visible")
+		}
+	})
+
+	t.Run("Object just inside the margin is visible", func(t *testing.T) {
+		x := viewport.MaxX - 1
+		if !viewport.Contains(x, 0, 0) {
+			t.Errorf("Object at x=%f should be inside viewport %+v", x, viewport)
+		}
+	})
+
+	t.Run("Object overlapping the edge is visible", func(t *testing.T) {
+		x := viewport.MaxX + 5
+		if !viewport.Contains(x, 0, 10) {
+			t.Errorf("Object at x=%f with radius 10 should overlap viewport %+v", x, viewport)
+		}
+	})
+
+	t.Run("Object far away is not visible", func(t *testing.T) {
+		if viewport.Contains(2500, 2500, 10) {
+			t.Errorf("Object at (2500, 2500) should not be inside viewport %+v", viewport)
+		}
+	})
+
+	t.Run("Bigger players see further", func(t *testing.T) {
+		big := ViewportFor(&Player{X: 0, Y: 0, Radius: SpawnRadius * 4})
+		if big.MaxX <= viewport.MaxX || big.MaxY <= viewport.MaxY {
+			t.Errorf("Viewport of a bigger player %+v should be larger than %+v", big, viewport)
+		}
+	})
+}
+
+// TestInterestSet tests enter/leave tracking of known objects
+func TestInterestSet(t *testing.T) {
+	t.Run("Update reports entered and left objects", func(t *testing.T) {
+		set := NewInterestSet()
+		set.Add(1)
+		set.Add(2)
+
+		entered, left := set.Update(map[uint64]struct{}{2: {}, 3: {}})
+		slices.Sort(entered)
+		slices.Sort(left)
+
+		if !slices.Equal(entered, []uint64{3}) {
+			t.Errorf("Expected object 3 to enter, got %v", entered)
+		}
+		if !slices.Equal(left, []uint64{1}) {
+			t.Errorf("Expected object 1 to leave, got %v", left)
+		}
+		if set.Has(1) || !set.Has(2) || !set.Has(3) {
+			t.Error("Known set should be exactly {2, 3} after the update")
+		}
+	})
+
+	t.Run("Unchanged visibility reports nothing", func(t *testing.T) {
+		set := NewInterestSet()
+		set.Update(map[uint64]struct{}{1: {}})
+
+		entered, left := set.Update(map[uint64]struct{}{1: {}})
+		if len(entered) != 0 || len(left) != 0 {
+			t.Errorf("Expected no changes, got entered %v and left %v", entered, left)
+		}
+	})
+}
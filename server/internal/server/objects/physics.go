@@ -0,0 +1,170 @@
+package objects
+
+import "math"
+
+// Distance from the boundary where the rubber-band starts pushing back
+const rubberBandZone float64 = 200.0
+
+// Fraction of a sliding spore's velocity lost per second, and the speed below which it stops
+const (
+	sporeFriction  float64 = 3.0
+	sporeRestSpeed float64 = 5.0
+)
+
+// How a player's speed falls off as it grows, so big players stay catchable
+type SpeedCurve struct {
+	// Speed of a spawn-sized player
+	BaseSpeed float64
+
+	// Speed is proportional to mass raised to the power of -Falloff
+	Falloff float64
+
+	MinSpeed float64
+	MaxSpeed float64
+}
+
+var DefaultSpeedCurve = SpeedCurve{BaseSpeed: 150, Falloff: 0.2, MinSpeed: 40, MaxSpeed: 200}
+
+func (c SpeedCurve) SpeedFor(mass float64) float64 {
+	if mass <= 0 {
+		return c.MaxSpeed
+	}
+	speed := c.BaseSpeed * math.Pow(RadToMass(SpawnRadius)/mass, c.Falloff)
+	return min(max(speed, c.MinSpeed), c.MaxSpeed)
+}
+
+// How quickly big players waste away, so sitting on a good score isn't a winning strategy
+type MassDecay struct {
+	// Players lighter than this don't decay
+	Threshold float64
+
+	// Fraction of the mass above the threshold lost per second
+	Rate float64
+}
+
+var DefaultMassDecay = MassDecay{Threshold: 10000, Rate: 0.02}
+
+// Shrinks every cell of the player in proportion to its size for delta seconds of decay
+func DecayPlayer(player *Player, decay MassDecay, delta float64) {
+	mass := player.Mass()
+	excess := mass - decay.Threshold
+	if excess <= 0 || decay.Rate <= 0 {
+		return
+	}
+
+	loss := excess * (1 - math.Exp(-decay.Rate*delta))
+	radiusScale := math.Sqrt((mass - loss) / mass)
+	player.ForEachCell(func(cell *Cell) {
+		cell.Radius *= radiusScale
+	})
+}
+
+// How a battle-royale border closes in on the players
+type ShrinkingBorder struct {
+	// Distance each edge moves inwards per second
+	Rate float64
+
+	// The border stops shrinking once it is this wide and tall
+	MinSize float64
+
+	// Fraction of a cell's mass lost per second while any of it is past the border
+	Damage float64
+}
+
+// Moves every edge of the bounds inwards for delta seconds, stopping at the minimum size
+func (b ShrinkingBorder) Shrink(bounds Bounds, delta float64) Bounds {
+	step := b.Rate * delta
+	stepX := max(min(step, (bounds.MaxX-bounds.MinX-b.MinSize)/2), 0)
+	stepY := max(min(step, (bounds.MaxY-bounds.MinY-b.MinSize)/2), 0)
+	return Bounds{
+		MinX: bounds.MinX + stepX,
+		MaxX: bounds.MaxX - stepX,
+		MinY: bounds.MinY + stepY,
+		MaxY: bounds.MaxY - stepY,
+	}
+}
+
+// Shrinks every cell of the player which pokes out of the bounds for delta seconds of damage.
+// Cells never shrink below the spawn size this way, so the border wears players down rather than
+// killing them outright.
+func (b ShrinkingBorder) DamagePlayer(player *Player, bounds Bounds, delta float64) {
+	radiusScale := math.Sqrt(math.Exp(-b.Damage * delta))
+	player.ForEachCell(func(cell *Cell) {
+		if cell.Radius <= SpawnRadius || bounds.Contains(cell.X, cell.Y, cell.Radius) {
+			return
+		}
+		cell.Radius = max(cell.Radius*radiusScale, SpawnRadius)
+	})
+}
+
+func RadToMass(radius float64) float64 {
+	return math.Pi * radius * radius
+}
+
+func MassToRad(mass float64) float64 {
+	return math.Sqrt(mass / math.Pi)
+}
+
+// Advances the player and its cells along its direction for delta seconds, keeping them inside the
+// given bounds
+func MovePlayer(player *Player, bounds Bounds, delta float64) {
+	speed := player.CurrentSpeed()
+	newX := player.X + speed*math.Cos(player.Direction)*delta
+	newY := player.Y + speed*math.Sin(player.Direction)*delta
+
+	// The player's radius acts as a buffer so its edge, not its center, touches the wall
+	player.X = rubberBand(newX, bounds.MinX+player.Radius, bounds.MaxX-player.Radius, speed, delta)
+	player.Y = rubberBand(newY, bounds.MinY+player.Radius, bounds.MaxY-player.Radius, speed, delta)
+
+	// Split cells follow the same direction, drifting back towards the main body as their boost fades
+	damping := math.Exp(-sporeFriction * delta)
+	for _, cell := range player.Cells {
+		velocityX := speed*math.Cos(player.Direction) + cell.VelocityX + (player.X-cell.X)*cellCohesion
+		velocityY := speed*math.Sin(player.Direction) + cell.VelocityY + (player.Y-cell.Y)*cellCohesion
+
+		cell.X = rubberBand(cell.X+velocityX*delta, bounds.MinX+cell.Radius, bounds.MaxX-cell.Radius, speed, delta)
+		cell.Y = rubberBand(cell.Y+velocityY*delta, bounds.MinY+cell.Radius, bounds.MaxY-cell.Radius, speed, delta)
+
+		cell.VelocityX *= damping
+		cell.VelocityY *= damping
+	}
+}
+
+// Slides a spore along its velocity for delta seconds, slowing it down and stopping it at the
+// bounds. Returns whether it is still moving.
+func MoveSpore(spore *Spore, bounds Bounds, delta float64) bool {
+	spore.X = min(max(spore.X+spore.VelocityX*delta, bounds.MinX+spore.Radius), bounds.MaxX-spore.Radius)
+	spore.Y = min(max(spore.Y+spore.VelocityY*delta, bounds.MinY+spore.Radius), bounds.MaxY-spore.Radius)
+
+	damping := math.Exp(-sporeFriction * delta)
+	spore.VelocityX *= damping
+	spore.VelocityY *= damping
+
+	if math.Hypot(spore.VelocityX, spore.VelocityY) < sporeRestSpeed {
+		spore.VelocityX, spore.VelocityY = 0, 0
+		return false
+	}
+	return true
+}
+
+// Creates a soft wall that pushes back with increasing force the deeper the position is in the
+// rubber-band zone, and hard clamps it at the boundary itself
+func rubberBand(pos, minBound, maxBound, speed, delta float64) float64 {
+	if pos < minBound {
+		pos = minBound
+	} else if pos < minBound+rubberBandZone {
+		distanceIntoBoundary := minBound + rubberBandZone - pos
+		resistance := distanceIntoBoundary / rubberBandZone // 0 to 1
+		pos += resistance * resistance * speed * delta * 2
+	}
+
+	if pos > maxBound {
+		pos = maxBound
+	} else if pos > maxBound-rubberBandZone {
+		distanceIntoBoundary := pos - (maxBound - rubberBandZone)
+		resistance := distanceIntoBoundary / rubberBandZone
+		pos -= resistance * resistance * speed * delta * 2
+	}
+
+	return pos
+}
@@ -0,0 +1,140 @@
+package objects
+
+import (
+	"math"
+	"testing"
+)
+
+// TestMassConversion tests converting between a circle's radius and its mass
+func TestMassConversion(t *testing.T) {
+	t.Run("Mass is the area of the circle", func(t *testing.T) {
+		if mass := RadToMass(10); math.Abs(mass-100*math.Pi) > 0.0001 {
+			t.Errorf("RadToMass(10) = %f, expected %f", mass, 100*math.Pi)
+		}
+		if radius := MassToRad(100 * math.Pi); math.Abs(radius-10) > 0.0001 {
+			t.Errorf("MassToRad(%f) = %f, expected 10", 100*math.Pi, radius)
+		}
+	})
+
+	t.Run("Conversions round trip", func(t *testing.T) {
+		for _, radius := range []float64{0, 1, SpawnRadius, 250} {
+			if back := MassToRad(RadToMass(radius)); math.Abs(back-radius) > 0.0001 {
+				t.Errorf("Round trip failed: radius %f came back as %f", radius, back)
+			}
+		}
+	})
+
+	t.Run("Adding masses adds areas", func(t *testing.T) {
+		radius := MassToRad(RadToMass(3) + RadToMass(4))
+		if math.Abs(radius-5) > 0.0001 {
+			t.Errorf("Combining radii 3 and 4 gave radius %f, expected 5", radius)
+		}
+	})
+}
+
+// TestSpeedCurve tests how player speed scales with mass
+func TestSpeedCurve(t *testing.T) {
+	curve := DefaultSpeedCurve
+
+	t.Run("Spawn-sized players move at the base speed", func(t *testing.T) {
+		if speed := curve.SpeedFor(RadToMass(SpawnRadius)); speed != curve.BaseSpeed {
+			t.Errorf("Expected speed %f at spawn size, got %f", curve.BaseSpeed, speed)
+		}
+	})
+
+	t.Run("Bigger players are slower", func(t *testing.T) {
+		small := curve.SpeedFor(RadToMass(SpawnRadius * 2))
+		big := curve.SpeedFor(RadToMass(SpawnRadius * 4))
+		if big >= small {
+			t.Errorf("Expected a bigger player to be slower, got %f vs %f", big, small)
+		}
+	})
+
+	t.Run("Speed stays within the caps", func(t *testing.T) {
+		if speed := curve.SpeedFor(RadToMass(SpawnRadius * 1000)); speed != curve.MinSpeed {
+			t.Errorf("Expected a huge player to move at the minimum speed %f, got %f", curve.MinSpeed, speed)
+		}
+		if speed := curve.SpeedFor(RadToMass(1)); speed != curve.MaxSpeed {
+			t.Errorf("Expected a tiny player to move at the maximum speed %f, got %f", curve.MaxSpeed, speed)
+		}
+	})
+}
+
+// TestDecayPlayer tests big players shrinking over time
+func TestDecayPlayer(t *testing.T) {
+	decay := MassDecay{Threshold: RadToMass(50), Rate: 0.1}
+
+	t.Run("Players below the threshold don't decay", func(t *testing.T) {
+		player := &Player{Radius: 40}
+		DecayPlayer(player, decay, 1)
+
+		if player.Radius != 40 {
+			t.Errorf("Expected radius to stay at 40, got %f", player.Radius)
+		}
+	})
+
+	t.Run("Big players shrink towards the threshold", func(t *testing.T) {
+		player := &Player{Radius: 100, Cells: []*Cell{{Radius: 100}}}
+		for range 1000 {
+			DecayPlayer(player, decay, 1)
+		}
+
+		if mass := player.Mass(); mass < decay.Threshold || mass > decay.Threshold*1.01 {
+			t.Errorf("Expected mass to settle just above %f, got %f", decay.Threshold, mass)
+		}
+		if math.Abs(player.Radius-player.Cells[0].Radius) > 0.0001 {
+			t.Errorf("Expected equal cells to decay equally, got %f and %f", player.Radius, player.Cells[0].Radius)
+		}
+	})
+}
+
+// TestShrinkingBorder tests the border closing in and hurting players caught outside it
+func TestShrinkingBorder(t *testing.T) {
+	border := ShrinkingBorder{Rate: 10, MinSize: 100, Damage: 0.5}
+
+	t.Run("Every edge moves inwards", func(t *testing.T) {
+		bounds := border.Shrink(Bounds{MinX: -1000, MaxX: 1000, MinY: -500, MaxY: 500}, 2)
+
+		expected := Bounds{MinX: -980, MaxX: 980, MinY: -480, MaxY: 480}
+		if bounds != expected {
+			t.Errorf("Expected %+v, got %+v", expected, bounds)
+		}
+	})
+
+	t.Run("Border stops at the minimum size", func(t *testing.T) {
+		bounds := Bounds{MinX: -60, MaxX: 60, MinY: -50, MaxY: 50}
+		for range 10 {
+			bounds = border.Shrink(bounds, 1)
+		}
+
+		expected := Bounds{MinX: -50, MaxX: 50, MinY: -50, MaxY: 50}
+		if bounds != expected {
+			t.Errorf("Expected %+v, got %+v", expected, bounds)
+		}
+	})
+
+	t.Run("Only cells poking out are damaged", func(t *testing.T) {
+		bounds := Bounds{MinX: -100, MaxX: 100, MinY: -100, MaxY: 100}
+		player := &Player{X: 0, Radius: 40, Cells: []*Cell{{X: 80, Radius: 40}}}
+		border.DamagePlayer(player, bounds, 1)
+
+		if player.Radius != 40 {
+			t.Errorf("Expected the main body inside the border to keep radius 40, got %f", player.Radius)
+		}
+		if radius := player.Cells[0].Radius; radius >= 40 {
+			t.Errorf("Expected the cell past the border to shrink, got radius %f", radius)
+		}
+	})
+
+	t.Run("Damage stops at the spawn size", func(t *testing.T) {
+		bounds := Bounds{MinX: -100, MaxX: 100, MinY: -100, MaxY: 100}
+		player := &Player{X: 500, Radius: SpawnRadius * 2}
+		for range 100 {
+			border.DamagePlayer(player, bounds, 1)
+		}
+
+		if player.Radius != SpawnRadius {
+			t.Errorf("Expected radius to bottom out at %f, got %f", SpawnRadius, player.Radius)
+		}
+	})
+}
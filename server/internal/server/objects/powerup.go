@@ -0,0 +1,68 @@
+package objects
+
+import "time"
+
+// What a power-up does for the player who picks it up. The values match those sent to clients.
+type PowerUpKind uint32
+
+const (
+	// Moves the player faster
+	PowerUpSpeed PowerUpKind = iota + 1
+
+	// Stops the player from being consumed
+	PowerUpShield
+
+	// Pulls nearby spores towards the player
+	PowerUpMagnet
+)
+
+var PowerUpKinds = []PowerUpKind{PowerUpSpeed, PowerUpShield, PowerUpMagnet}
+
+// Radius of every power-up pickup, and how long the power-up lasts once picked up
+const (
+	PowerUpRadius   float64       = 15
+	PowerUpDuration time.Duration = 10 * time.Second
+)
+
+// How much faster a speed power-up makes the player
+const SpeedBoost float64 = 1.5
+
+// How far beyond the player's edge a magnet reaches, and how fast it pulls spores in
+const (
+	MagnetRange float64 = 250
+	MagnetPull  float64 = 300
+)
+
+// Gives the player the power-up until the given time. Picking up one the player already has
+// extends it rather than stacking.
+func (p *Player) GrantPowerUp(kind PowerUpKind, until time.Time) {
+	if p.PowerUps == nil {
+		p.PowerUps = make(map[PowerUpKind]time.Time)
+	}
+	p.PowerUps[kind] = until
+}
+
+func (p *Player) HasPowerUp(kind PowerUpKind) bool {
+	_, ok := p.PowerUps[kind]
+	return ok
+}
+
+// Takes away every power-up which has worn off by now. Returns whether any did.
+func (p *Player) ExpirePowerUps(now time.Time) bool {
+	expired := false
+	for kind, until := range p.PowerUps {
+		if !now.Before(until) {
+			delete(p.PowerUps, kind)
+			expired = true
+		}
+	}
+	return expired
+}
+
+// The player's speed including any boost it has picked up
+func (p *Player) CurrentSpeed() float64 {
+	if p.HasPowerUp(PowerUpSpeed) {
+		return p.Speed * SpeedBoost
+	}
+	return p.Speed
+}
@@ -0,0 +1,48 @@
+package objects
+
+import (
+	"testing"
+	"time"
+)
+
+// TestPowerUps tests granting power-ups to players and taking them away again
+func TestPowerUps(t *testing.T) {
+	t.Run("Power-ups last until they expire", func(t *testing.T) {
+		now := time.Now()
+		player := &Player{}
+		player.GrantPowerUp(PowerUpShield, now.Add(PowerUpDuration))
+
+		if player.ExpirePowerUps(now.Add(PowerUpDuration/2)) || !player.HasPowerUp(PowerUpShield) {
+			t.Error("Expected the shield to still be active half way through")
+		}
+		if !player.ExpirePowerUps(now.Add(PowerUpDuration)) || player.HasPowerUp(PowerUpShield) {
+			t.Error("Expected the shield to have worn off")
+		}
+	})
+
+	t.Run("Picking up a power-up again extends it", func(t *testing.T) {
+		now := time.Now()
+		player := &Player{}
+		player.GrantPowerUp(PowerUpMagnet, now.Add(PowerUpDuration))
+		player.GrantPowerUp(PowerUpMagnet, now.Add(2*PowerUpDuration))
+
+		player.ExpirePowerUps(now.Add(PowerUpDuration))
+		if !player.HasPowerUp(PowerUpMagnet) {
+			t.Error("Expected the magnet to last until the later expiry")
+		}
+	})
+
+	t.Run("Speed power-up boosts movement", func(t *testing.T) {
+		player := &Player{Speed: 100, Radius: SpawnRadius}
+		player.GrantPowerUp(PowerUpSpeed, time.Now().Add(PowerUpDuration))
+
+		MovePlayer(player, DefaultBounds, 1)
+
+		if speed := player.CurrentSpeed(); speed != 100*SpeedBoost {
+			t.Errorf("Expected boosted speed %f, got %f", 100*SpeedBoost, speed)
+		}
+		if player.X != 100*SpeedBoost {
+			t.Errorf("Expected the player to move %f, got %f", 100*SpeedBoost, player.X)
+		}
+	})
+}
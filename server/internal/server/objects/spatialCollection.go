@@ -0,0 +1,85 @@
+package objects
+
+// Anything with a circular footprint in the game world
+type Locatable interface {
+	Position() (float64, float64)
+	Size() float64
+}
+
+// A SharedCollection which also keeps a spatial index of its objects for proximity queries.
+// Objects which move or change size must be re-indexed with Reindex.
+type SpatialCollection[T Locatable] struct {
+	*SharedCollection[T]
+	grid *SpatialGrid
+}
+
+func NewSpatialCollection[T Locatable](capacity ...int) *SpatialCollection[T] {
+	return &SpatialCollection[T]{
+		SharedCollection: NewSharedCollection[T](capacity...),
+		grid:             NewSpatialGrid(DefaultGridCellSize),
+	}
+}
+
+func (s *SpatialCollection[T]) Add(obj T, id ...uint64) uint64 {
+	thisId := s.SharedCollection.Add(obj, id...)
+	x, y := obj.Position()
+	s.grid.Insert(thisId, x, y, obj.Size())
+	return thisId
+}
+
+func (s *SpatialCollection[T]) Remove(id uint64) {
+	s.SharedCollection.Remove(id)
+	s.grid.Remove(id)
+}
+
+// Updates the spatial index with the object's current position and size
+func (s *SpatialCollection[T]) Reindex(id uint64) {
+	obj, ok := s.Get(id)
+	if !ok {
+		return
+	}
+	x, y := obj.Position()
+	s.grid.Insert(id, x, y, obj.Size())
+}
+
+// Objects overlapping the circle at (x, y) with the given radius
+func (s *SpatialCollection[T]) Within(x, y, radius float64) map[uint64]T {
+	return s.lookup(s.grid.QueryRadius(x, y, radius))
+}
+
+// Whether any object overlaps the circle at (x, y) with the given radius. Safe to call on a nil
+// collection, which is empty.
+func (s *SpatialCollection[T]) Overlaps(x, y, radius float64) bool {
+	if s == nil {
+		return false
+	}
+	return len(s.Within(x, y, radius)) > 0
+}
+
+// Objects overlapping the viewport
+func (s *SpatialCollection[T]) InViewport(viewport Viewport) map[uint64]T {
+	return s.lookup(s.grid.QueryViewport(viewport))
+}
+
+// Object whose center is closest to (x, y), searching no further than maxDistance
+func (s *SpatialCollection[T]) Nearest(x, y, maxDistance float64) (uint64, T, bool) {
+	id, found := s.grid.Nearest(x, y, maxDistance)
+	if !found {
+		var zero T
+		return 0, zero, false
+	}
+
+	obj, ok := s.Get(id)
+	return id, obj, ok
+}
+
+func (s *SpatialCollection[T]) lookup(ids []uint64) map[uint64]T {
+	found := make(map[uint64]T, len(ids))
+	for _, id := range ids {
+		// The index may briefly lag behind the collection while an object is being added or removed
+		if obj, ok := s.Get(id); ok {
+			found[id] = obj
+		}
+	}
+	return found
+}
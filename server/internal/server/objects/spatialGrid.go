@@ -0,0 +1,184 @@
+package objects
+
+import (
+	"math"
+	"sync"
+)
+
+// Width and height of each grid cell. Roughly the size of a big player, so most objects only
+// occupy one or a handful of cells.
+const DefaultGridCellSize float64 = 250
+
+type gridCell struct {
+	x int
+	y int
+}
+
+type gridEntry struct {
+	x       float64
+	y       float64
+	radius  float64
+	minCell gridCell
+	maxCell gridCell
+}
+
+// A thread-safe uniform grid of circles for answering proximity queries without scanning every
+// object in the world. Circles are stored in every cell they overlap.
+type SpatialGrid struct {
+	cellSize float64
+	cells    map[gridCell]map[uint64]struct{}
+	entries  map[uint64]gridEntry
+	gridMux  sync.Mutex
+}
+
+func NewSpatialGrid(cellSize float64) *SpatialGrid {
+	return &SpatialGrid{
+		cellSize: cellSize,
+		cells:    make(map[gridCell]map[uint64]struct{}),
+		entries:  make(map[uint64]gridEntry),
+	}
+}
+
+func (g *SpatialGrid) cellAt(x, y float64) gridCell {
+	return gridCell{
+		x: int(math.Floor(x / g.cellSize)),
+		y: int(math.Floor(y / g.cellSize)),
+	}
+}
+
+// Inserts the circle with the given ID, or moves it if it is already in the grid
+func (g *SpatialGrid) Insert(id uint64, x, y, radius float64) {
+	g.gridMux.Lock()
+	defer g.gridMux.Unlock()
+
+	minCell := g.cellAt(x-radius, y-radius)
+	maxCell := g.cellAt(x+radius, y+radius)
+
+	if old, ok := g.entries[id]; ok {
+		// Only touch the cells if the circle actually moved into different ones
+		if old.minCell == minCell && old.maxCell == maxCell {
+			g.entries[id] = gridEntry{x: x, y: y, radius: radius, minCell: minCell, maxCell: maxCell}
+			return
+		}
+		g.removeLocked(id, old)
+	}
+
+	for cx := minCell.x; cx <= maxCell.x; cx++ {
+		for cy := minCell.y; cy <= maxCell.y; cy++ {
+			cell := gridCell{cx, cy}
+			ids, ok := g.cells[cell]
+			if !ok {
+				ids = make(map[uint64]struct{})
+				g.cells[cell] = ids
+			}
+			ids[id] = struct{}{}
+		}
+	}
+	g.entries[id] = gridEntry{x: x, y: y, radius: radius, minCell: minCell, maxCell: maxCell}
+}
+
+func (g *SpatialGrid) Remove(id uint64) {
+	g.gridMux.Lock()
+	defer g.gridMux.Unlock()
+
+	if entry, ok := g.entries[id]; ok {
+		g.removeLocked(id, entry)
+	}
+}
+
+func (g *SpatialGrid) removeLocked(id uint64, entry gridEntry) {
+	for cx := entry.minCell.x; cx <= entry.maxCell.x; cx++ {
+		for cy := entry.minCell.y; cy <= entry.maxCell.y; cy++ {
+			cell := gridCell{cx, cy}
+			delete(g.cells[cell], id)
+			if len(g.cells[cell]) == 0 {
+				delete(g.cells, cell)
+			}
+		}
+	}
+	delete(g.entries, id)
+}
+
+// Calls the callback once for each entry stored in any cell between min and max. Must be called
+// with the lock held.
+func (g *SpatialGrid) forEachInCells(minCell, maxCell gridCell, callback func(uint64, gridEntry)) {
+	visited := make(map[uint64]struct{})
+	for cx := minCell.x; cx <= maxCell.x; cx++ {
+		for cy := minCell.y; cy <= maxCell.y; cy++ {
+			for id := range g.cells[gridCell{cx, cy}] {
+				if _, ok := visited[id]; ok {
+					continue
+				}
+				visited[id] = struct{}{}
+				callback(id, g.entries[id])
+			}
+		}
+	}
+}
+
+// IDs of all circles overlapping the circle at (x, y) with the given radius
+func (g *SpatialGrid) QueryRadius(x, y, radius float64) []uint64 {
+	g.gridMux.Lock()
+	defer g.gridMux.Unlock()
+
+	var ids []uint64
+	g.forEachInCells(g.cellAt(x-radius, y-radius), g.cellAt(x+radius, y+radius), func(id uint64, entry gridEntry) {
+		dx := entry.x - x
+		dy := entry.y - y
+		reach := entry.radius + radius
+		if dx*dx+dy*dy <= reach*reach {
+			ids = append(ids, id)
+		}
+	})
+	return ids
+}
+
+// IDs of all circles overlapping the viewport
+func (g *SpatialGrid) QueryViewport(viewport Viewport) []uint64 {
+	g.gridMux.Lock()
+	defer g.gridMux.Unlock()
+
+	var ids []uint64
+	g.forEachInCells(g.cellAt(viewport.MinX, viewport.MinY), g.cellAt(viewport.MaxX, viewport.MaxY), func(id uint64, entry gridEntry) {
+		if viewport.Contains(entry.x, entry.y, entry.radius) {
+			ids = append(ids, id)
+		}
+	})
+	return ids
+}
+
+// ID of the circle whose center is closest to (x, y), searching no further than maxDistance
+func (g *SpatialGrid) Nearest(x, y, maxDistance float64) (uint64, bool) {
+	// Widen the search until it finds something. Anything closer than the search radius is
+	// guaranteed to have been seen, so the first hit within it is the nearest.
+	for searchRadius := g.cellSize; ; searchRadius *= 2 {
+		searchRadius = min(searchRadius, maxDistance)
+
+		bestId, bestDistSq, found := g.nearestWithin(x, y, searchRadius)
+		if found && bestDistSq <= searchRadius*searchRadius {
+			return bestId, true
+		}
+
+		if searchRadius >= maxDistance {
+			return 0, false
+		}
+	}
+}
+
+func (g *SpatialGrid) nearestWithin(x, y, radius float64) (uint64, float64, bool) {
+	g.gridMux.Lock()
+	defer g.gridMux.Unlock()
+
+	var bestId uint64
+	bestDistSq := math.Inf(1)
+	found := false
+	g.forEachInCells(g.cellAt(x-radius, y-radius), g.cellAt(x+radius, y+radius), func(id uint64, entry gridEntry) {
+		dx := entry.x - x
+		dy := entry.y - y
+		distSq := dx*dx + dy*dy
+		if distSq < bestDistSq || (distSq == bestDistSq && id < bestId) {
+			bestId, bestDistSq, found = id, distSq, true
+		}
+	})
+	return bestId, bestDistSq, found
+}
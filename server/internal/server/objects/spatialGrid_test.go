@@ -0,0 +1,169 @@
+package objects
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// TestSpatialGrid tests proximity queries against the uniform grid
+func TestSpatialGrid(t *testing.T) {
+	t.Run("QueryRadius finds overlapping circles only", func(t *testing.T) {
+		grid := NewSpatialGrid(100)
+		grid.Insert(1, 0, 0, 10)
+		grid.Insert(2, 50, 0, 10)
+		grid.Insert(3, 500, 500, 10)
+
+		ids := grid.QueryRadius(0, 0, 45)
+		slices.Sort(ids)
+
+		if !slices.Equal(ids, []uint64{1, 2}) {
+			t.Errorf("Expected circles 1 and 2 to overlap the query, got %v", ids)
+		}
+	})
+
+	t.Run("Large circles are found from any cell they cover", func(t *testing.T) {
+		grid := NewSpatialGrid(100)
+		grid.Insert(1, 0, 0, 350)
+
+		ids := grid.QueryRadius(300, 0, 10)
+		if !slices.Equal(ids, []uint64{1}) {
+			t.Errorf("Expected large circle to be found at its edge, got %v", ids)
+		}
+	})
+
+	t.Run("Moved circles are found at their new position only", func(t *testing.T) {
+		grid := NewSpatialGrid(100)
+		grid.Insert(1, 0, 0, 10)
+		grid.Insert(1, 1000, 1000, 10)
+
+		if ids := grid.QueryRadius(0, 0, 50); len(ids) != 0 {
+			t.Errorf("Expected nothing at the old position, got %v", ids)
+		}
+		if ids := grid.QueryRadius(1000, 1000, 50); !slices.Equal(ids, []uint64{1}) {
+			t.Errorf("Expected circle at the new position, got %v", ids)
+		}
+	})
+
+	t.Run("Removed circles are not found", func(t *testing.T) {
+		grid := NewSpatialGrid(100)
+		grid.Insert(1, 0, 0, 10)
+		grid.Remove(1)
+
+		if ids := grid.QueryRadius(0, 0, 50); len(ids) != 0 {
+			t.Errorf("Expected nothing after removal, got %v", ids)
+		}
+	})
+
+	t.Run("QueryViewport finds circles overlapping the rectangle", func(t *testing.T) {
+		grid := NewSpatialGrid(100)
+		grid.Insert(1, 0, 0, 10)
+		grid.Insert(2, 205, 0, 10)
+		grid.Insert(3, 400, 0, 10)
+
+		ids := grid.QueryViewport(Viewport{MinX: -200, MaxX: 200, MinY: -200, MaxY: 200})
+		slices.Sort(ids)
+
+		if !slices.Equal(ids, []uint64{1, 2}) {
+			t.Errorf("Expected circles 1 and 2 in the viewport, got %v", ids)
+		}
+	})
+
+	t.Run("Nearest finds the closest circle", func(t *testing.T) {
+		grid := NewSpatialGrid(100)
+		grid.Insert(1, 900, 0, 10)
+		grid.Insert(2, -300, 0, 10)
+		grid.Insert(3, 0, 700, 10)
+
+		id, found := grid.Nearest(0, 0, 5000)
+		if !found || id != 2 {
+			t.Errorf("Expected circle 2 to be nearest, got %d (found: %v)", id, found)
+		}
+	})
+
+	t.Run("Nearest respects the maximum distance", func(t *testing.T) {
+		grid := NewSpatialGrid(100)
+		grid.Insert(1, 900, 0, 10)
+
+		if id, found := grid.Nearest(0, 0, 500); found {
+			t.Errorf("Expected nothing within 500 units, got %d", id)
+		}
+	})
+
+	t.Run("Nearest agrees with a brute force search", func(t *testing.T) {
+		grid := NewSpatialGrid(DefaultGridCellSize)
+		type point struct{ x, y float64 }
+		points := make(map[uint64]point)
+		for id := uint64(1); id <= 500; id++ {
+			p := point{rand.Float64()*6000 - 3000, rand.Float64()*6000 - 3000}
+			points[id] = p
+			grid.Insert(id, p.x, p.y, 5)
+		}
+
+		for range 50 {
+			x, y := rand.Float64()*6000-3000, rand.Float64()*6000-3000
+
+			bestDistSq := -1.0
+			for _, p := range points {
+				distSq := (p.x-x)*(p.x-x) + (p.y-y)*(p.y-y)
+				if bestDistSq < 0 || distSq < bestDistSq {
+					bestDistSq = distSq
+				}
+			}
+
+			id, found := grid.Nearest(x, y, 20000)
+			if !found {
+				t.Fatalf("Expected to find a nearest circle to (%f, %f)", x, y)
+			}
+			p := points[id]
+			if distSq := (p.x-x)*(p.x-x) + (p.y-y)*(p.y-y); distSq != bestDistSq {
+				t.Errorf("Nearest to (%f, %f) returned distSq %f, brute force found %f", x, y, distSq, bestDistSq)
+			}
+		}
+	})
+}
+
+// TestSpawnCoords tests that spawned objects don't overlap existing ones
+func TestSpawnCoords(t *testing.T) {
+	spores := NewSpatialCollection[*Spore]()
+	for range 200 {
+		x, y := SpawnCoords(10, DefaultBounds, nil, spores)
+		spores.Add(&Spore{X: x, Y: y, Radius: 10})
+	}
+
+	spores.ForEach(func(sporeId uint64, spore *Spore) {
+		for otherId := range spores.Within(spore.X, spore.Y, spore.Radius) {
+			if otherId != sporeId {
+				t.Errorf("Spore %d overlaps spore %d", sporeId, otherId)
+			}
+		}
+	})
+}
+
+// TestSpawnCoordsCrowded tests that bounds with no room left still get objects inside them
+func TestSpawnCoordsCrowded(t *testing.T) {
+	bounds := Bounds{MinX: -300, MaxX: 300, MinY: -300, MaxY: 300}
+	spores := NewSpatialCollection[*Spore]()
+	for range 1000 {
+		x, y := SpawnCoords(10, bounds, spores)
+		if !bounds.Contains(x, y, 10) {
+			t.Fatalf("Spore spawned at (%f, %f), outside %+v", x, y, bounds)
+		}
+		spores.Add(&Spore{X: x, Y: y, Radius: 10})
+	}
+
+	if x, y := SpawnCoords(500, bounds); x != 0 || y != 0 {
+		t.Errorf("Expected an object too big for the bounds to go in the middle, got (%f, %f)", x, y)
+	}
+}
+
+// Benchmark placing a full world's worth of spores
+func BenchmarkSpawnSpores(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		spores := NewSpatialCollection[*Spore](1000)
+		for range 1000 {
+			x, y := SpawnCoords(10, DefaultBounds, nil, spores)
+			spores.Add(&Spore{X: x, Y: y, Radius: 10})
+		}
+	}
+}
@@ -2,7 +2,7 @@ package objects
 
 import "math/rand/v2"
 
-// Game world boundaries - players and spores cannot go beyond these coordinates
+// Default game world boundaries - players and spores cannot go beyond these coordinates
 const (
 	MinX float64 = -3000
 	MaxX float64 = 3000
@@ -10,55 +10,63 @@ const (
 	MaxY float64 = 3000
 )
 
-func SpawnCoords(radius float64, playersToAvoid *SharedCollection[*Player], sporesToAvoid *SharedCollection[*Spore]) (float64, float64) {
-	bound := MaxX // Use the boundary constant instead of hardcoded value
-	const maxTries int = 25
+// Rectangular area of a game world
+type Bounds struct {
+	MinX float64
+	MaxX float64
+	MinY float64
+	MaxY float64
+}
 
-	tries := 0
-	for {
-		x := bound * (2*rand.Float64() - 1)
-		y := bound * (2*rand.Float64() - 1)
+var DefaultBounds = Bounds{MinX: MinX, MaxX: MaxX, MinY: MinY, MaxY: MaxY}
 
-		if !isTooClose(x, y, radius, playersToAvoid, getPlayerPosition, getPlayerRadius) &&
-			!isTooClose(x, y, radius, sporesToAvoid, getSporePosition, getSporeRadius) {
-			return x, y
-		}
+// Whether the whole of a circle lies within the bounds
+func (b Bounds) Contains(x, y, radius float64) bool {
+	return x-radius >= b.MinX && x+radius <= b.MaxX && y-radius >= b.MinY && y+radius <= b.MaxY
+}
 
-		tries++
-		if tries > maxTries {
-			bound *= 2
-			tries = 0
-		}
-	}
+// Radius every player starts with
+const SpawnRadius float64 = 20
+
+// Radius of every virus
+const VirusRadius float64 = 50
+
+// Anything that takes up space new objects shouldn't be spawned on top of
+type Obstacle interface {
+	Overlaps(x, y, radius float64) bool
 }
 
-func isTooClose[T any](x float64, y float64, radius float64, objects *SharedCollection[T], getPosition func(T) (float64, float64), getRadius func(T) float64) bool {
-	if objects == nil {
-		return false
+// Picks somewhere wholly inside the bounds for an object of the given radius, clear of the
+// obstacles if there is room. Bounds too crowded to find a clear spot get an overlapping one.
+func SpawnCoords(radius float64, bounds Bounds, avoid ...Obstacle) (float64, float64) {
+	// Only the object's center is picked, so keep it a radius clear of each edge
+	minX, maxX := bounds.MinX+radius, bounds.MaxX-radius
+	minY, maxY := bounds.MinY+radius, bounds.MaxY-radius
+	if minX > maxX {
+		minX, maxX = (bounds.MinX+bounds.MaxX)/2, (bounds.MinX+bounds.MaxX)/2
 	}
+	if minY > maxY {
+		minY, maxY = (bounds.MinY+bounds.MaxY)/2, (bounds.MinY+bounds.MaxY)/2
+	}
+	const maxTries int = 50
 
-	tooClose := false
-	objects.ForEach(func(_ uint64, object T) {
-		if tooClose {
-			return
-		}
-
-		objX, objY := getPosition(object)
-		objRad := getRadius(object)
-		xDst := objX - x
-		yDst := objY - y
-		dstSq := xDst*xDst + yDst*yDst
+	var x, y float64
+	for range maxTries {
+		x = minX + (maxX-minX)*rand.Float64()
+		y = minY + (maxY-minY)*rand.Float64()
 
-		if dstSq <= (radius+objRad)*(radius+objRad) {
-			tooClose = true
-			return
+		if !isTooClose(x, y, radius, avoid) {
+			return x, y
 		}
-	})
-
-	return tooClose
+	}
+	return x, y
 }
 
-var getPlayerPosition = func(p *Player) (float64, float64) { return p.X, p.Y }
-var getPlayerRadius = func(p *Player) float64 { return p.Radius }
-var getSporePosition = func(s *Spore) (float64, float64) { return s.X, s.Y }
-var getSporeRadius = func(s *Spore) float64 { return s.Radius }
+func isTooClose(x float64, y float64, radius float64, obstacles []Obstacle) bool {
+	for _, obstacle := range obstacles {
+		if obstacle != nil && obstacle.Overlaps(x, y, radius) {
+			return true
+		}
+	}
+	return false
+}
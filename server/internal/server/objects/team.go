@@ -0,0 +1,22 @@
+package objects
+
+// RGBA colors shared by every member of a team, in team order starting from team 1
+var TeamColors = []uint32{
+	0xe6194bff, // Red
+	0x4363d8ff, // Blue
+	0x3cb44bff, // Green
+	0xffe119ff, // Yellow
+}
+
+// The color the player is drawn in: its team's if it is on one, otherwise its own
+func (p *Player) DisplayColor() int32 {
+	if p.Team > 0 && p.Team <= len(TeamColors) {
+		return int32(TeamColors[p.Team-1])
+	}
+	return p.Color
+}
+
+// Whether two players are on the same team
+func Teammates(a, b *Player) bool {
+	return a.Team != 0 && a.Team == b.Team
+}
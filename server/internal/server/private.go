@@ -0,0 +1,225 @@
+package server
+
+import (
+	"math/rand/v2"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"strings"
+	"time"
+)
+
+// Private room codes are this many characters drawn from an alphabet without look-alikes such as
+// O and 0, so they are easy to read out to friends
+const (
+	RoomCodeLength   int    = 6
+	roomCodeAlphabet string = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+// Largest number of players a private room can be set to hold
+const MaxPrivateRoomPlayers int = 20
+
+// Private rooms are torn down once nobody has been in them for this long
+const EmptyRoomTimeout = 30 * time.Second
+
+// Settings for a room created by a player. Private rooms are smaller than the public ones and
+// don't have bots.
+func PrivateRoomConfig(name string, maxPlayers int) RoomConfig {
+	return RoomConfig{
+		Name:        name,
+		MaxSpores:   MaxSpores / 4,
+		MaxViruses:  MaxViruses / 4,
+		MaxPowerUps: MaxPowerUps / 2,
+		MaxPlayers:  maxPlayers,
+		Bounds:      objects.Bounds{MinX: -1500, MaxX: 1500, MinY: -1500, MaxY: 1500},
+		SpeedCurve:  objects.DefaultSpeedCurve,
+		MassDecay:   objects.DefaultMassDecay,
+	}
+}
+
+// Opens a room which only players with its code can join, owned by the client with the given ID
+func (h *Hub) OpenPrivateRoom(config RoomConfig, ownerId uint64) *Room {
+	room := NewRoom(config)
+	room.Code = h.newRoomCode()
+	room.ownerId = ownerId
+	return h.startRoom(room)
+}
+
+func (h *Hub) newRoomCode() string {
+	code := make([]byte, RoomCodeLength)
+	for {
+		for i := range code {
+			code[i] = roomCodeAlphabet[rand.IntN(len(roomCodeAlphabet))]
+		}
+		if _, taken := RoomByCode(h.Rooms, string(code)); !taken {
+			return string(code)
+		}
+	}
+}
+
+// The open private room with the given code. Codes aren't case sensitive.
+func RoomByCode(rooms *objects.SharedCollection[*Room], code string) (*Room, bool) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return nil, false
+	}
+
+	var found *Room
+	rooms.ForEach(func(_ uint64, room *Room) {
+		if room.Code == code && !room.Closed() {
+			found = room
+		}
+	})
+	return found, found != nil
+}
+
+// Whether only players with the room's code can join it
+func (r *Room) IsPrivate() bool {
+	return r.Code != ""
+}
+
+// ID of the client allowed to run the room, or 0 if nobody is
+func (r *Room) OwnerId() uint64 {
+	r.membersMux.Lock()
+	defer r.membersMux.Unlock()
+
+	return r.ownerId
+}
+
+// Passes ownership to whichever remaining player has been connected the longest, and tells the
+// room who that is
+func (r *Room) handOver() {
+	r.membersMux.Lock()
+	r.ownerId = 0
+	r.Clients.ForEach(func(clientId uint64, _ ClientInterfacer) {
+		if r.ownerId == 0 || clientId < r.ownerId {
+			r.ownerId = clientId
+		}
+	})
+	ownerId := r.ownerId
+	r.membersMux.Unlock()
+
+	if ownerId == 0 {
+		return
+	}
+	select {
+	case r.BroadcastChan <- &packets.Packet{SenderId: 0, Msg: packets.NewRoomOwner(ownerId)}:
+	default:
+		r.logger.Printf("BroadcastChan full, dropping new owner notification for client %d", ownerId)
+	}
+}
+
+// Removes the client from the room and stops whoever is behind it coming back, even on another
+// connection. Returns false if it isn't in the room.
+func (r *Room) Kick(clientId uint64, reason string) bool {
+	client, exists := r.Clients.Get(clientId)
+	if !exists {
+		return false
+	}
+
+	r.membersMux.Lock()
+	r.kicked[r.members[clientId]] = struct{}{}
+	r.membersMux.Unlock()
+
+	// The client's state takes it out of the room on receiving this
+	client.ProcessMessage(0, packets.NewKicked(reason))
+	return true
+}
+
+// Sends everybody in the room back to the lobby and stops its simulation
+func (r *Room) Close(reason string) {
+	r.closeOnce.Do(func() {
+		r.logger.Printf("Closing because: %s", reason)
+		close(r.done)
+
+		kick := func(_ uint64, client ClientInterfacer) {
+			client.ProcessMessage(0, packets.NewKicked(reason))
+		}
+		r.Clients.ForEach(kick)
+		r.Spectators.ForEach(kick)
+	})
+}
+
+func (r *Room) Closed() bool {
+	select {
+	case <-r.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// Forgets closed rooms, and closes private rooms which have been left empty for too long
+func (h *Hub) reapRoomsLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	emptySince := make(map[uint64]time.Time)
+	for now := range ticker.C {
+		h.reapRooms(now, emptySince)
+	}
+}
+
+// One sweep of the reaper. emptySince keeps track of when each private room was first seen empty
+// between sweeps.
+func (h *Hub) reapRooms(now time.Time, emptySince map[uint64]time.Time) {
+	h.Rooms.ForEach(func(roomId uint64, room *Room) {
+		if !room.IsPrivate() {
+			return
+		}
+
+		if room.Closed() {
+			h.Rooms.Remove(roomId)
+			delete(emptySince, roomId)
+			return
+		}
+
+		if room.Clients.Len()+room.Spectators.Len() > 0 {
+			delete(emptySince, roomId)
+			return
+		}
+
+		since, seen := emptySince[roomId]
+		if !seen {
+			emptySince[roomId] = now
+			return
+		}
+		if now.Sub(since) >= EmptyRoomTimeout {
+			room.Close("Nobody was left in the room")
+			h.Rooms.Remove(roomId)
+			delete(emptySince, roomId)
+		}
+	})
+}
+
+// Longest a room's owner can mute a player for
+const MaxMute = time.Hour
+
+// Stops the client chatting in the room for the given time, or lets it chat again if the time is
+// 0. Returns false if it isn't in the room.
+func (r *Room) Mute(clientId uint64, duration time.Duration) bool {
+	if _, exists := r.Clients.Get(clientId); !exists {
+		return false
+	}
+
+	member := r.memberOf(clientId)
+	if duration <= 0 {
+		r.Mutes.Unmute(member)
+	} else {
+		r.Mutes.Mute(member, r.Chat.Now().Add(min(duration, MaxMute)))
+	}
+	return true
+}
+
+// How much longer the client is muted for, or 0 if it isn't
+func (r *Room) MutedFor(clientId uint64) time.Duration {
+	return r.Mutes.MutedFor(r.memberOf(clientId), r.Chat.Now())
+}
+
+// Returns why the client can't send the chat message, or nil if it can. Muted players are turned
+// away before the chat rules see their message.
+func (r *Room) CheckChat(clientId uint64, text string) error {
+	if err := r.Mutes.Check(r.memberOf(clientId), r.Chat.Now()); err != nil {
+		return err
+	}
+	return r.Chat.Check(clientId, text)
+}
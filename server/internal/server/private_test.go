@@ -0,0 +1,242 @@
+package server
+
+import (
+	"errors"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Stands in for a connected client, recording what it is sent. Anything else panics.
+type testClient struct {
+	ClientInterfacer
+	id       uint64
+	address  string
+	received []packets.Msg
+	mux      sync.Mutex
+}
+
+func (c *testClient) Id() uint64            { return c.id }
+func (c *testClient) RemoteAddress() string { return c.address }
+
+func (c *testClient) ProcessMessage(_ uint64, message packets.Msg) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.received = append(c.received, message)
+}
+
+func (c *testClient) kickedFor() string {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	for _, message := range c.received {
+		if kicked, ok := message.(*packets.Packet_Kicked); ok {
+			return kicked.Kicked.Reason
+		}
+	}
+	return ""
+}
+
+func testHub() *Hub {
+	return &Hub{
+		Clients: objects.NewSharedCollection[ClientInterfacer](),
+		Rooms:   objects.NewSharedCollection[*Room](),
+	}
+}
+
+func testPrivateRoom(hub *Hub, ownerId uint64) *Room {
+	room := NewRoom(PrivateRoomConfig("Friends", 3))
+	room.Code = hub.newRoomCode()
+	room.ownerId = ownerId
+	room.Id = hub.Rooms.Add(room)
+	return room
+}
+
+// TestPrivateRooms tests rooms joined by code and run by their owner
+func TestPrivateRooms(t *testing.T) {
+	t.Run("Rooms are found by code whatever the case", func(t *testing.T) {
+		hub := testHub()
+		room := testPrivateRoom(hub, 1)
+		hub.Rooms.Add(NewRoom(RoomConfig{Name: "Public"}))
+
+		if len(room.Code) != RoomCodeLength {
+			t.Fatalf("Expected a %d character code, got %q", RoomCodeLength, room.Code)
+		}
+		found, ok := RoomByCode(hub.Rooms, " "+strings.ToLower(room.Code)+" ")
+		if !ok || found != room {
+			t.Errorf("Expected to find the room by its code in any case, got %v", found)
+		}
+		if _, ok := RoomByCode(hub.Rooms, ""); ok {
+			t.Error("Expected public rooms not to match an empty code")
+		}
+	})
+
+	t.Run("Joining respects the player limit", func(t *testing.T) {
+		room := testPrivateRoom(testHub(), 1)
+		for id := range uint64(3) {
+			if err := room.Join(&testClient{id: id + 1}, nil); err != nil {
+				t.Fatalf("Expected player %d to get in, got %v", id+1, err)
+			}
+		}
+		if err := room.Join(&testClient{id: 4}, nil); !errors.Is(err, ErrRoomFull) {
+			t.Errorf("Expected the room to be full, got %v", err)
+		}
+
+		room.SetMaxPlayers(4)
+		if err := room.Join(&testClient{id: 4}, nil); err != nil {
+			t.Errorf("Expected a raised limit to let another player in, got %v", err)
+		}
+	})
+
+	t.Run("Kicked players are sent away and can't come back", func(t *testing.T) {
+		room := testPrivateRoom(testHub(), 1)
+		target := &testClient{id: 2}
+		player := &objects.Player{UserId: 7}
+		room.Join(target, player)
+
+		if !room.Kick(2, "Kicked by Owner") {
+			t.Fatal("Expected the kick to succeed")
+		}
+		if reason := target.kickedFor(); reason != "Kicked by Owner" {
+			t.Errorf("Expected the player to be told it was kicked, got %q", reason)
+		}
+
+		room.Leave(target)
+		if err := room.Join(&testClient{id: 3}, player); !errors.Is(err, ErrKicked) {
+			t.Errorf("Expected the kicked player to be turned away on a new connection, got %v", err)
+		}
+		if err := room.Join(&testClient{id: 4}, &objects.Player{UserId: 8}); err != nil {
+			t.Errorf("Expected other players to still get in, got %v", err)
+		}
+		if room.Kick(5, "") {
+			t.Error("Expected kicking somebody not in the room to fail")
+		}
+	})
+
+	t.Run("Kicked guests are kept out by address", func(t *testing.T) {
+		room := testPrivateRoom(testHub(), 1)
+		target := &testClient{id: 2, address: "203.0.113.7"}
+		room.Join(target, &objects.Player{Guest: true})
+
+		room.Kick(2, "Kicked by Owner")
+		room.Leave(target)
+
+		if err := room.Join(&testClient{id: 3, address: "203.0.113.7"}, &objects.Player{Guest: true}); !errors.Is(err, ErrKicked) {
+			t.Errorf("Expected the kicked guest to be turned away under a new name, got %v", err)
+		}
+	})
+
+	t.Run("Muted players can't chat until unmuted, even on a new connection", func(t *testing.T) {
+		room := testPrivateRoom(testHub(), 1)
+		target := &testClient{id: 2}
+		room.Join(target, &objects.Player{UserId: 7})
+
+		if !room.Mute(2, 2*MaxMute) {
+			t.Fatal("Expected the mute to succeed")
+		}
+		if mutedFor := room.MutedFor(2); mutedFor <= 0 || mutedFor > MaxMute {
+			t.Errorf("Expected the mute to be capped at %v, got %v", MaxMute, mutedFor)
+		}
+
+		room.Leave(target)
+		room.Join(&testClient{id: 3}, &objects.Player{UserId: 7})
+		if room.CheckChat(3, "hello") == nil {
+			t.Error("Expected coming back on a new connection not to lift the mute")
+		}
+
+		room.Mute(3, 0)
+		if err := room.CheckChat(3, "hello"); err != nil {
+			t.Errorf("Expected the player to chat again once unmuted, got %v", err)
+		}
+		if room.Mute(4, time.Minute) {
+			t.Error("Expected muting somebody not in the room to fail")
+		}
+	})
+
+	t.Run("Muted guests stay muted by address", func(t *testing.T) {
+		room := testPrivateRoom(testHub(), 1)
+		target := &testClient{id: 2, address: "203.0.113.7"}
+		room.Join(target, &objects.Player{Guest: true})
+
+		room.Mute(2, time.Minute)
+		room.Leave(target)
+		room.Join(&testClient{id: 3, address: "203.0.113.7"}, &objects.Player{Guest: true})
+
+		if room.CheckChat(3, "hello") == nil {
+			t.Error("Expected the muted guest to stay muted under a new name")
+		}
+	})
+
+	t.Run("Ownership passes on when the owner leaves", func(t *testing.T) {
+		room := testPrivateRoom(testHub(), 1)
+		owner := &testClient{id: 1}
+		room.Join(owner, nil)
+		room.Join(&testClient{id: 5}, nil)
+		room.Join(&testClient{id: 3}, nil)
+
+		room.Leave(owner)
+
+		if ownerId := room.OwnerId(); ownerId != 3 {
+			t.Errorf("Expected the longest connected player 3 to take over, got %d", ownerId)
+		}
+		packet := <-room.BroadcastChan
+		if packet.GetRoomOwner().GetPlayerId() != 3 {
+			t.Errorf("Expected the room to be told about its new owner, got %v", packet)
+		}
+	})
+
+	t.Run("Closing sends everybody away", func(t *testing.T) {
+		room := testPrivateRoom(testHub(), 1)
+		player := &testClient{id: 1}
+		spectator := &testClient{id: 2}
+		room.Join(player, nil)
+		room.Watch(spectator)
+
+		room.Close("Owner closed the room")
+
+		if player.kickedFor() == "" || spectator.kickedFor() == "" {
+			t.Error("Expected the player and spectator to be sent away")
+		}
+		if err := room.Join(&testClient{id: 3}, nil); !errors.Is(err, ErrRoomClosed) {
+			t.Errorf("Expected the closed room to turn players away, got %v", err)
+		}
+		if _, ok := RoomByCode(testHub().Rooms, room.Code); ok {
+			t.Error("Expected closed rooms not to be found by code")
+		}
+	})
+
+	t.Run("Empty rooms are torn down after a while", func(t *testing.T) {
+		hub := testHub()
+		public := hub.Rooms.Add(NewRoom(RoomConfig{Name: "Public"}))
+		room := testPrivateRoom(hub, 1)
+		occupied := testPrivateRoom(hub, 2)
+		occupied.Join(&testClient{id: 2}, nil)
+
+		start := time.Now()
+		emptySince := make(map[uint64]time.Time)
+		hub.reapRooms(start, emptySince)
+		hub.reapRooms(start.Add(EmptyRoomTimeout/2), emptySince)
+		if room.Closed() {
+			t.Fatal("Expected the empty room to get some time for players to arrive")
+		}
+
+		hub.reapRooms(start.Add(EmptyRoomTimeout), emptySince)
+
+		if !room.Closed() {
+			t.Error("Expected the empty room to be closed")
+		}
+		if _, exists := hub.Rooms.Get(room.Id); exists {
+			t.Error("Expected the empty room to be forgotten")
+		}
+		if _, exists := hub.Rooms.Get(occupied.Id); !exists || occupied.Closed() {
+			t.Error("Expected the occupied room to stay open")
+		}
+		if _, exists := hub.Rooms.Get(public); !exists {
+			t.Error("Expected the empty public room to stay open")
+		}
+	})
+}
@@ -0,0 +1,487 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"server/internal/server/chat"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"sync"
+	"time"
+)
+
+// Settings for a room's world
+type RoomConfig struct {
+	Name        string
+	MaxSpores   int
+	MaxViruses  int
+	MaxPowerUps int
+
+	// 0 means no limit
+	MaxPlayers int
+
+	// Bots top the room up to this many players. 0 means no bots.
+	MinPlayers int
+
+	Bounds objects.Bounds
+
+	// A zero curve leaves player speeds as they are
+	SpeedCurve objects.SpeedCurve
+
+	// A zero decay rate means players never shrink on their own
+	MassDecay objects.MassDecay
+
+	// Length of each round, after which the arena is reset. 0 means the room never resets.
+	RoundDuration time.Duration
+
+	// Number of teams players are split between. 0 means every player is out for themselves.
+	Teams int
+
+	// A zero rate keeps the bounds where they are. The border grows back when a round ends.
+	Border objects.ShrinkingBorder
+}
+
+// Rooms every server starts with
+var DefaultRooms = []RoomConfig{
+	{
+		Name:        "Casual",
+		MaxSpores:   MaxSpores,
+		MaxViruses:  MaxViruses,
+		MaxPowerUps: MaxPowerUps,
+		MinPlayers:  10,
+		Bounds:      objects.DefaultBounds,
+		SpeedCurve:  objects.DefaultSpeedCurve,
+		MassDecay:   objects.DefaultMassDecay,
+	},
+	{
+		Name:          "Ranked",
+		MaxSpores:     MaxSpores,
+		MaxViruses:    MaxViruses,
+		MaxPowerUps:   MaxPowerUps,
+		MaxPlayers:    50,
+		Bounds:        objects.DefaultBounds,
+		SpeedCurve:    objects.DefaultSpeedCurve,
+		MassDecay:     objects.DefaultMassDecay,
+		RoundDuration: 10 * time.Minute,
+	},
+	{
+		Name:          "Royale",
+		MaxSpores:     MaxSpores,
+		MaxViruses:    MaxViruses,
+		MaxPowerUps:   MaxPowerUps,
+		MaxPlayers:    30,
+		MinPlayers:    8,
+		Bounds:        objects.DefaultBounds,
+		SpeedCurve:    objects.DefaultSpeedCurve,
+		MassDecay:     objects.DefaultMassDecay,
+		RoundDuration: 5 * time.Minute,
+		Border:        objects.ShrinkingBorder{Rate: 9, MinSize: 600, Damage: 0.2},
+	},
+	{
+		Name:        "Teams",
+		MaxSpores:   MaxSpores,
+		MaxViruses:  MaxViruses,
+		MaxPowerUps: MaxPowerUps,
+		MaxPlayers:  40,
+		MinPlayers:  8,
+		Teams:       2,
+		Bounds:      objects.DefaultBounds,
+		SpeedCurve:  objects.DefaultSpeedCurve,
+		MassDecay:   objects.DefaultMassDecay,
+	},
+	{
+		Name:        "Test",
+		MaxSpores:   200,
+		MaxViruses:  5,
+		MaxPowerUps: 3,
+		MaxPlayers:  10,
+		MinPlayers:  3,
+		Bounds:      objects.Bounds{MinX: -1500, MaxX: 1500, MinY: -1500, MaxY: 1500},
+		SpeedCurve:  objects.DefaultSpeedCurve,
+		MassDecay:   objects.DefaultMassDecay,
+	},
+}
+
+var (
+	ErrRoomFull   = errors.New("room is full")
+	ErrRoomClosed = errors.New("room is closed")
+	ErrKicked     = errors.New("you were kicked from this room")
+)
+
+// An independent game world with its own players, spores and simulation loop
+type Room struct {
+	Id         uint64
+	Name       string
+	SpeedCurve objects.SpeedCurve
+	MassDecay  objects.MassDecay
+
+	// The bounds the room opens with, and where its border currently is
+	arena     objects.Bounds
+	bounds    objects.Bounds
+	boundsMux sync.Mutex
+
+	Border           objects.ShrinkingBorder
+	lastBorderUpdate time.Time
+
+	// When the world tick last had the room's bots pick where to go
+	lastBotThink time.Time
+
+	MaxSpores   int
+	MaxViruses  int
+	MaxPowerUps int
+	MinPlayers  int
+	Teams       int
+
+	// Who may join. Guarded by membersMux since a private room's owner can change it at any time.
+	// Kicks are kept by member key, so kicked players can't get back in on a new connection.
+	maxPlayers int
+	ownerId    uint64
+	members    map[uint64]string
+	kicked     map[string]struct{}
+	membersMux sync.Mutex
+
+	// Private rooms can only be joined by this code. Public rooms don't have one.
+	Code string
+
+	// Closed once the room shuts down, stopping its loops
+	done      chan struct{}
+	closeOnce sync.Once
+
+	RoundDuration time.Duration
+	roundEndsAt   time.Time
+	lastCountdown int
+
+	// Clients currently in the room, whether playing or not
+	Clients *objects.SharedCollection[ClientInterfacer]
+
+	// Clients watching the room. They receive its broadcasts but don't count towards its players.
+	Spectators *objects.SharedCollection[ClientInterfacer]
+
+	// Packets in this channel will be processed by all clients in the room except the sender
+	BroadcastChan chan *packets.Packet
+
+	// Chat messages have to get past this before they are broadcast
+	Chat *chat.Moderator
+
+	// Kept by member key like kicks, so muted players stay muted on a new connection
+	Mutes *chat.MuteList
+
+	SharedGameObjects *SharedGameObjects
+
+	// Subset of the room's spores which are still sliding after being ejected
+	movingSpores *objects.SharedCollection[*objects.Spore]
+
+	// Tells the spore loop the arena was cleared, so it should put every spore back
+	refillSpores chan struct{}
+
+	// For recording round results. Rooms without one, such as in tests, don't record them.
+	dbTx *DbTx
+
+	logger *log.Logger
+}
+
+func NewRoom(config RoomConfig) *Room {
+	return &Room{
+		Name:          config.Name,
+		arena:         config.Bounds,
+		bounds:        config.Bounds,
+		Border:        config.Border,
+		SpeedCurve:    config.SpeedCurve,
+		MassDecay:     config.MassDecay,
+		MaxSpores:     config.MaxSpores,
+		MaxViruses:    config.MaxViruses,
+		MaxPowerUps:   config.MaxPowerUps,
+		maxPlayers:    config.MaxPlayers,
+		members:       make(map[uint64]string),
+		kicked:        make(map[string]struct{}),
+		done:          make(chan struct{}),
+		MinPlayers:    config.MinPlayers,
+		Teams:         config.Teams,
+		RoundDuration: config.RoundDuration,
+		Clients:       objects.NewSharedCollection[ClientInterfacer](),
+		Spectators:    objects.NewSharedCollection[ClientInterfacer](),
+		BroadcastChan: make(chan *packets.Packet, 2000),
+		Chat:          chat.NewModerator(chat.DefaultRules()...),
+		Mutes:         chat.NewMuteList(),
+		SharedGameObjects: &SharedGameObjects{
+			Players:  objects.NewSpatialCollection[*objects.Player](),
+			Spores:   objects.NewSpatialCollection[*objects.Spore](config.MaxSpores),
+			Viruses:  objects.NewSpatialCollection[*objects.Virus](config.MaxViruses),
+			PowerUps: objects.NewSpatialCollection[*objects.PowerUp](config.MaxPowerUps),
+		},
+		movingSpores: objects.NewSharedCollection[*objects.Spore](),
+		refillSpores: make(chan struct{}, 1),
+		logger:       log.New(log.Writer(), fmt.Sprintf("Room [%s]: ", config.Name), log.LstdFlags),
+	}
+}
+
+func (r *Room) Run() {
+	r.logger.Println("Placing spores...")
+	for i := 0; i < r.MaxSpores; i++ {
+		r.SharedGameObjects.Spores.Add(r.newSpore())
+	}
+	for i := 0; i < r.MaxViruses; i++ {
+		r.SharedGameObjects.Viruses.Add(r.newVirus())
+	}
+	for i := 0; i < r.MaxPowerUps; i++ {
+		r.SharedGameObjects.PowerUps.Add(r.newPowerUp())
+	}
+
+	go r.replenishSporesLoop(2 * time.Second)
+	go r.replenishVirusesLoop(10 * time.Second)
+	go r.replenishPowerUpsLoop(5 * time.Second)
+	go r.worldTickLoop(TickRate)
+	go r.leaderboardLoop(time.Second)
+
+	for {
+		select {
+		case <-r.done:
+			r.logger.Println("Closed")
+			return
+		case packet := <-r.BroadcastChan:
+			deliver := func(clientId uint64, client ClientInterfacer) {
+				if clientId != packet.SenderId {
+					client.ProcessMessage(packet.SenderId, packet.Msg)
+				}
+			}
+			r.Clients.ForEach(deliver)
+			r.Spectators.ForEach(deliver)
+		}
+	}
+}
+
+// Adds the client to the room to play as the player, so long as there is space for another player
+// and it hasn't been kicked out before
+func (r *Room) Join(client ClientInterfacer, player *objects.Player) error {
+	r.membersMux.Lock()
+	defer r.membersMux.Unlock()
+
+	key := memberKey(client, player)
+	if r.Closed() {
+		return ErrRoomClosed
+	}
+	if _, kicked := r.kicked[key]; kicked {
+		return ErrKicked
+	}
+	if r.maxPlayers > 0 && r.Clients.Len() >= r.maxPlayers {
+		return ErrRoomFull
+	}
+	r.members[client.Id()] = key
+	r.Clients.Add(client, client.Id())
+	return nil
+}
+
+// Who is behind a client, whichever connection they are on. Players are known by their account.
+// Guests get a new name every time, so they are known by where they connect from instead.
+func memberKey(client ClientInterfacer, player *objects.Player) string {
+	switch {
+	case player != nil && player.UserId != 0:
+		return fmt.Sprintf("user %d", player.UserId)
+	case client.RemoteAddress() != "":
+		return "address " + client.RemoteAddress()
+	}
+	return fmt.Sprintf("client %d", client.Id())
+}
+
+// The member key the client joined under
+func (r *Room) memberOf(clientId uint64) string {
+	r.membersMux.Lock()
+	defer r.membersMux.Unlock()
+
+	return r.members[clientId]
+}
+
+// Adds the client as a spectator. There is no limit on spectators.
+func (r *Room) Watch(client ClientInterfacer) {
+	r.Spectators.Add(client, client.Id())
+}
+
+func (r *Room) Leave(client ClientInterfacer) {
+	r.membersMux.Lock()
+	delete(r.members, client.Id())
+	r.membersMux.Unlock()
+
+	r.Clients.Remove(client.Id())
+	r.Spectators.Remove(client.Id())
+	r.SharedGameObjects.Players.Remove(client.Id())
+	r.Chat.Forget(client.Id())
+
+	if client.Id() == r.OwnerId() {
+		r.handOver()
+	}
+}
+
+// 0 means no limit
+func (r *Room) MaxPlayers() int {
+	r.membersMux.Lock()
+	defer r.membersMux.Unlock()
+
+	return r.maxPlayers
+}
+
+// Players already in the room get to stay even if there are now too many of them
+func (r *Room) SetMaxPlayers(maxPlayers int) {
+	r.membersMux.Lock()
+	defer r.membersMux.Unlock()
+
+	r.maxPlayers = maxPlayers
+}
+
+// Where the room's border currently is. Players and spores are kept inside it.
+func (r *Room) Bounds() objects.Bounds {
+	r.boundsMux.Lock()
+	defer r.boundsMux.Unlock()
+
+	return r.bounds
+}
+
+func (r *Room) setBounds(bounds objects.Bounds) {
+	r.boundsMux.Lock()
+	defer r.boundsMux.Unlock()
+
+	r.bounds = bounds
+}
+
+func (r *Room) replenishSporesLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	for {
+		// Spores are normally topped up a few at a time, but all at once after the arena is cleared
+		batch, perPause := 10, 1
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+		case <-r.refillSpores:
+			batch, perPause = r.MaxSpores, 10
+		}
+
+		sporesRemaining := r.SharedGameObjects.Spores.Len()
+		diff := r.MaxSpores - sporesRemaining
+
+		if diff <= 0 {
+			continue
+		}
+
+		r.logger.Printf("%d spores remain - going to replenish %d spores", sporesRemaining, diff)
+
+		for i := 0; i < min(diff, batch); i++ {
+			spore := r.newSpore()
+			sporeId := r.SharedGameObjects.Spores.Add(spore)
+
+			packet := &packets.Packet{
+				SenderId: 0,
+				Msg:      packets.NewSpore(sporeId, spore),
+			}
+			select {
+			case r.BroadcastChan <- packet:
+			default:
+				r.logger.Printf("BroadcastChan full, dropping spore spawn notification for spore %d", sporeId)
+			}
+
+			if (i+1)%perPause == 0 {
+				time.Sleep(50 * time.Millisecond)
+			}
+		}
+	}
+}
+
+// Viruses are few and far between, so put back every one that has been popped in one go
+func (r *Room) replenishVirusesLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+		}
+
+		for i := r.SharedGameObjects.Viruses.Len(); i < r.MaxViruses; i++ {
+			virus := r.newVirus()
+			virusId := r.SharedGameObjects.Viruses.Add(virus)
+
+			packet := &packets.Packet{
+				SenderId: 0,
+				Msg:      packets.NewVirus(virusId, virus),
+			}
+			select {
+			case r.BroadcastChan <- packet:
+			default:
+				r.logger.Printf("BroadcastChan full, dropping virus spawn notification for virus %d", virusId)
+			}
+		}
+	}
+}
+
+// Power-ups are handed out one at a time so they stay a prize worth racing for
+func (r *Room) replenishPowerUpsLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+		}
+
+		if r.SharedGameObjects.PowerUps.Len() >= r.MaxPowerUps {
+			continue
+		}
+
+		powerUp := r.newPowerUp()
+		powerUpId := r.SharedGameObjects.PowerUps.Add(powerUp)
+
+		packet := &packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewPowerUp(powerUpId, powerUp),
+		}
+		select {
+		case r.BroadcastChan <- packet:
+		default:
+			r.logger.Printf("BroadcastChan full, dropping power-up spawn notification for power-up %d", powerUpId)
+		}
+	}
+}
+
+func (r *Room) newSpore() *objects.Spore {
+	sporeRadius := max(rand.NormFloat64()*3+10, 5)
+	x, y := objects.SpawnCoords(sporeRadius, r.Bounds(), r.obstacles()...)
+	return &objects.Spore{X: x, Y: y, Radius: sporeRadius}
+}
+
+func (r *Room) newVirus() *objects.Virus {
+	x, y := objects.SpawnCoords(objects.VirusRadius, r.Bounds(), r.obstacles()...)
+	return &objects.Virus{X: x, Y: y, Radius: objects.VirusRadius}
+}
+
+func (r *Room) newPowerUp() *objects.PowerUp {
+	x, y := objects.SpawnCoords(objects.PowerUpRadius, r.Bounds(), r.obstacles()...)
+	kind := objects.PowerUpKinds[rand.IntN(len(objects.PowerUpKinds))]
+	return &objects.PowerUp{X: x, Y: y, Radius: objects.PowerUpRadius, Kind: kind}
+}
+
+// Everything new objects shouldn't be spawned on top of
+func (r *Room) obstacles() []objects.Obstacle {
+	return []objects.Obstacle{
+		r.SharedGameObjects.Players,
+		r.SharedGameObjects.Spores,
+		r.SharedGameObjects.Viruses,
+		r.SharedGameObjects.PowerUps,
+	}
+}
+
+// Summary of the room for clients choosing which to join
+func (r *Room) Info() *packets.RoomMessage {
+	return &packets.RoomMessage{
+		Id:          r.Id,
+		Name:        r.Name,
+		PlayerCount: uint32(r.Clients.Len()),
+		MaxPlayers:  uint32(r.MaxPlayers()),
+	}
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"database/sql"
+	"math"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"time"
+)
+
+// Number of players announced as winners at the end of a round
+const RoundWinners = 3
+
+// Counts down the current round, ending it and starting the next once time is up. Returns the
+// packets to broadcast, or nil if the room doesn't run rounds or there is nothing new to say.
+func (r *Room) stepRound(now time.Time) []*packets.Packet {
+	if r.RoundDuration <= 0 {
+		return nil
+	}
+
+	if r.roundEndsAt.IsZero() {
+		r.roundEndsAt = now.Add(r.RoundDuration)
+	}
+
+	remaining := r.roundEndsAt.Sub(now)
+	if remaining <= 0 {
+		r.roundEndsAt = now.Add(r.RoundDuration)
+		r.lastCountdown = 0
+		return []*packets.Packet{r.endRound(now)}
+	}
+
+	// Only say something when the number of whole seconds left changes
+	secondsLeft := int(math.Ceil(remaining.Seconds()))
+	if secondsLeft == r.lastCountdown {
+		return nil
+	}
+	r.lastCountdown = secondsLeft
+	return []*packets.Packet{{
+		SenderId: 0,
+		Msg:      packets.NewRoundCountdown(uint32(secondsLeft)),
+	}}
+}
+
+// Records the final standings, resets the arena and announces the winners
+func (r *Room) endRound(now time.Time) *packets.Packet {
+	ranking := r.Ranking()
+	r.logger.Printf("Round over with %d players", len(ranking))
+
+	go r.recordRoundResults(r.roundResults(ranking, now))
+
+	r.resetArena()
+
+	return &packets.Packet{
+		SenderId: 0,
+		Msg:      packets.NewRoundEnd(ranking[:min(len(ranking), RoundWinners)]),
+	}
+}
+
+// The results worth keeping from the ranking. Bots and guests have no account to keep them for.
+func (r *Room) roundResults(ranking []*packets.LeaderboardEntryMessage, endedAt time.Time) []db.CreateRoundResultParams {
+	var results []db.CreateRoundResultParams
+	for _, entry := range ranking {
+		player, ok := r.SharedGameObjects.Players.Get(entry.PlayerId)
+		if !ok || player.DbId == 0 || player.Guest {
+			continue
+		}
+		results = append(results, db.CreateRoundResultParams{
+			RoomName: r.Name,
+			EndedAt:  endedAt,
+			Rank:     int32(entry.Rank),
+			Name:     entry.Name,
+			Score:    int32(entry.Score),
+			PlayerID: sql.NullInt32{Int32: player.DbId, Valid: true},
+		})
+	}
+	return results
+}
+
+func (r *Room) recordRoundResults(results []db.CreateRoundResultParams) {
+	if r.dbTx == nil {
+		return
+	}
+
+	for _, result := range results {
+		if err := r.dbTx.Queries.CreateRoundResult(r.dbTx.Ctx, result); err != nil {
+			r.logger.Printf("Error recording round result for %s: %v", result.Name, err)
+		}
+	}
+}
+
+// Shrinks every player back to spawn size somewhere new, and replaces every virus and power-up.
+// Spores are too many to put back within a tick, so the spore loop is told to refill them. A
+// shrinking border is pushed back out to the edge of the arena.
+func (r *Room) resetArena() {
+	r.setBounds(r.arena)
+	// Have the border announce itself again on the next tick
+	r.lastBorderUpdate = time.Time{}
+
+	clearCollection(r.SharedGameObjects.Spores)
+	clearCollection(r.SharedGameObjects.Viruses)
+	clearCollection(r.SharedGameObjects.PowerUps)
+	r.movingSpores.ForEach(func(sporeId uint64, _ *objects.Spore) {
+		r.movingSpores.Remove(sporeId)
+	})
+
+	r.SharedGameObjects.Players.ForEach(func(playerId uint64, player *objects.Player) {
+		player.Radius = objects.SpawnRadius
+		player.Cells = nil
+		player.PowerUps = nil
+		player.X, player.Y = objects.SpawnCoords(player.Radius, r.Bounds(), r.SharedGameObjects.Players)
+		r.SharedGameObjects.Players.Reindex(playerId)
+	})
+
+	for i := 0; i < r.MaxViruses; i++ {
+		r.SharedGameObjects.Viruses.Add(r.newVirus())
+	}
+	for i := 0; i < r.MaxPowerUps; i++ {
+		r.SharedGameObjects.PowerUps.Add(r.newPowerUp())
+	}
+	select {
+	case r.refillSpores <- struct{}{}:
+	default:
+		// A refill is already on its way
+	}
+}
+
+func clearCollection[T objects.Locatable](collection *objects.SpatialCollection[T]) {
+	collection.ForEach(func(id uint64, _ T) {
+		collection.Remove(id)
+	})
+}
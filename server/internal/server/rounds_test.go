@@ -0,0 +1,83 @@
+package server
+
+import (
+	"server/internal/server/objects"
+	"testing"
+	"time"
+)
+
+func testRoundRoom() *Room {
+	return NewRoom(RoomConfig{Name: "Test", MaxSpores: 50, MaxViruses: 2, Bounds: objects.DefaultBounds, RoundDuration: time.Minute})
+}
+
+// TestRounds tests timed rounds counting down and resetting the arena
+func TestRounds(t *testing.T) {
+	t.Run("Rooms without rounds say nothing", func(t *testing.T) {
+		if events := testRoom().stepRound(time.Now()); events != nil {
+			t.Errorf("Expected no round packets, got %v", events)
+		}
+	})
+
+	t.Run("Countdown is sent once per second", func(t *testing.T) {
+		room := testRoundRoom()
+		start := time.Now()
+
+		first := room.stepRound(start)
+		if len(first) != 1 || first[0].GetRoundCountdown().GetSecondsLeft() != 60 {
+			t.Fatalf("Expected a countdown from 60 seconds, got %v", first)
+		}
+		if events := room.stepRound(start.Add(100 * time.Millisecond)); len(events) != 0 {
+			t.Errorf("Expected no countdown within the same second, got %v", events)
+		}
+		if events := room.stepRound(start.Add(1500 * time.Millisecond)); len(events) != 1 || events[0].GetRoundCountdown().GetSecondsLeft() != 59 {
+			t.Errorf("Expected a countdown at 59 seconds, got %v", events)
+		}
+	})
+
+	t.Run("Round end announces winners and resets the arena", func(t *testing.T) {
+		room := testRoundRoom()
+		start := time.Now()
+		room.stepRound(start)
+
+		big := &objects.Player{Name: "Big", Radius: 80, Cells: []*objects.Cell{{X: 200, Radius: 40}}}
+		room.SharedGameObjects.Players.Add(big, 1)
+		room.SharedGameObjects.Players.Add(&objects.Player{Name: "Small", X: 1000, Radius: 30}, 2)
+		room.SharedGameObjects.Spores.Add(&objects.Spore{X: 500, Radius: 10})
+
+		events := room.stepRound(start.Add(time.Minute))
+
+		if len(events) != 1 {
+			t.Fatalf("Expected a single round end packet, got %v", events)
+		}
+		winners := events[0].GetRoundEnd().GetWinners()
+		if len(winners) != 2 || winners[0].Name != "Big" || winners[1].Name != "Small" {
+			t.Errorf("Expected Big then Small as winners, got %v", winners)
+		}
+
+		if big.Radius != objects.SpawnRadius || len(big.Cells) != 0 {
+			t.Errorf("Expected players back at spawn size, got %+v", big)
+		}
+		if room.SharedGameObjects.Spores.Len() != 0 || room.SharedGameObjects.Viruses.Len() != 2 {
+			t.Errorf("Expected the spores cleared and a fresh set of 2 viruses, got %d and %d", room.SharedGameObjects.Spores.Len(), room.SharedGameObjects.Viruses.Len())
+		}
+		if len(room.refillSpores) != 1 {
+			t.Error("Expected the spore loop to be told to refill the arena")
+		}
+		if !room.roundEndsAt.Equal(start.Add(2 * time.Minute)) {
+			t.Errorf("Expected the next round to end at %v, got %v", start.Add(2*time.Minute), room.roundEndsAt)
+		}
+	})
+
+	t.Run("Results are only kept for registered players", func(t *testing.T) {
+		room := testRoundRoom()
+		room.SharedGameObjects.Players.Add(&objects.Player{Name: "Alice", Radius: 60, DbId: 4}, 1)
+		room.SharedGameObjects.Players.Add(&objects.Player{Name: "Blobby (bot)", Radius: 50, IsBot: true}, 2)
+		room.SharedGameObjects.Players.Add(&objects.Player{Name: "Guest3", Radius: 40, Guest: true}, 3)
+
+		results := room.roundResults(room.Ranking(), time.Now())
+
+		if len(results) != 1 || results[0].Name != "Alice" || results[0].PlayerID.Int32 != 4 || results[0].Rank != 1 {
+			t.Errorf("Expected only Alice's first place to be kept, got %+v", results)
+		}
+	})
+}
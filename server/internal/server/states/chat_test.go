@@ -0,0 +1,84 @@
+package states
+
+import (
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"strings"
+	"testing"
+)
+
+// The last message the client was sent, if it was a deny response
+func lastDenied(client *testClient) (string, bool) {
+	if len(client.sent) == 0 {
+		return "", false
+	}
+	deny, ok := client.sent[len(client.sent)-1].(*packets.Packet_DenyResponse)
+	if !ok {
+		return "", false
+	}
+	return deny.DenyResponse.Reason, true
+}
+
+// TestChatModeration tests that chat goes through the room's moderation before being broadcast
+func TestChatModeration(t *testing.T) {
+	t.Run("Accepted messages are broadcast", func(t *testing.T) {
+		client := &testClient{id: 1, room: server.NewRoom(server.RoomConfig{})}
+		game := &InGame{}
+		game.SetClient(client)
+
+		game.handleChat(1, packets.NewChat("hello").(*packets.Packet_Chat))
+
+		if len(client.broadcasted) != 1 || len(client.sent) != 0 {
+			t.Errorf("Expected the message to be broadcast and nothing sent back, got %v and %v", client.broadcasted, client.sent)
+		}
+	})
+
+	t.Run("Rejected messages are only answered to the sender", func(t *testing.T) {
+		client := &testClient{id: 1, room: server.NewRoom(server.RoomConfig{})}
+		game := &InGame{}
+		game.SetClient(client)
+
+		game.handleChat(1, packets.NewChat(strings.Repeat("a", 1000)).(*packets.Packet_Chat))
+
+		if len(client.broadcasted) != 0 {
+			t.Errorf("Expected nothing to be broadcast, got %v", client.broadcasted)
+		}
+		if reason, ok := lastDenied(client); !ok || !strings.HasPrefix(reason, "Message not sent") {
+			t.Errorf("Expected the sender to be told why, got %v", client.sent)
+		}
+	})
+
+	t.Run("Muted players are told so", func(t *testing.T) {
+		room := server.NewRoom(server.RoomConfig{})
+		client := &testClient{id: 1, room: room}
+		room.Join(client, &objects.Player{UserId: 1})
+		room.Mute(1, server.MaxMute)
+		game := &InGame{}
+		game.SetClient(client)
+
+		game.handleChat(1, packets.NewChat("hello").(*packets.Packet_Chat))
+
+		if reason, ok := lastDenied(client); !ok || !strings.Contains(reason, "muted") || len(client.broadcasted) != 0 {
+			t.Errorf("Expected the muted player to be told instead of being heard, got %v", client.sent)
+		}
+	})
+
+	t.Run("Only the room's owner can mute players", func(t *testing.T) {
+		room := server.NewRoom(server.RoomConfig{})
+		client := &testClient{id: 1, room: room}
+		room.Clients.Add(client, 1)
+		room.Clients.Add(&testClient{id: 2, room: room}, 2)
+		game := &InGame{}
+		game.SetClient(client)
+
+		game.handleMuteRequest(1, &packets.Packet_MuteRequest{MuteRequest: &packets.MuteRequestMessage{PlayerId: 2, Seconds: 60}})
+
+		if reason, ok := lastDenied(client); !ok || !strings.Contains(reason, "owner") {
+			t.Errorf("Expected to be told only the owner can mute, got %v", client.sent)
+		}
+		if room.MutedFor(2) != 0 {
+			t.Error("Expected the player not to be muted")
+		}
+	})
+}
@@ -1,15 +1,22 @@
 package states
 
 import (
+	"cmp"
 	"context"
+	"crypto/rand"
+	"database/sql"
 	"errors"
 	"fmt"
 	"log"
+	mathrand "math/rand/v2"
+	"slices"
 	"strings"
+	"time"
 
 	"golang.org/x/crypto/bcrypt"
 
 	"server/internal/server"
+	"server/internal/server/auth"
 	"server/internal/server/db"
 	"server/internal/server/objects"
 	"server/pkg/packets"
@@ -20,6 +27,9 @@ type Connected struct {
 	logger  *log.Logger
 	queries *db.Queries
 	dbCtx   context.Context
+
+	// Set once the client has logged in, ready to be taken into a room
+	player *objects.Player
 }
 
 func (c *Connected) Name() string {
@@ -36,6 +46,11 @@ func (c *Connected) SetClient(client server.ClientInterfacer) {
 
 func (c *Connected) OnEnter() {
 	c.client.SocketSend(packets.NewId(c.client.Id()))
+
+	// Coming back from a room while still logged in
+	if c.player != nil {
+		c.sendRoomList()
+	}
 }
 
 func (c *Connected) OnExit() {
@@ -50,6 +65,28 @@ func (c *Connected) HandleMessage(senderId uint64, message packets.Msg) {
 		c.handleRegisterRequest(senderId, message)
 	case *packets.Packet_HiScoreBoardRequest:
 		c.handleHiscoreBoardRequest(senderId, message)
+	case *packets.Packet_RoomListRequest:
+		c.handleRoomListRequest(senderId, message)
+	case *packets.Packet_JoinRoomRequest:
+		c.handleJoinRoomRequest(senderId, message)
+	case *packets.Packet_SpectateRequest:
+		c.handleSpectateRequest(senderId, message)
+	case *packets.Packet_CreateRoomRequest:
+		c.handleCreateRoomRequest(senderId, message)
+	case *packets.Packet_JoinByCodeRequest:
+		c.handleJoinByCodeRequest(senderId, message)
+	case *packets.Packet_ResumeRequest:
+		c.handleResumeRequest(senderId, message)
+	case *packets.Packet_TokenLoginRequest:
+		c.handleTokenLoginRequest(senderId, message)
+	case *packets.Packet_LogoutRequest:
+		c.handleLogoutRequest(senderId, message)
+	case *packets.Packet_ChangePasswordRequest:
+		c.handleChangePasswordRequest(senderId, message)
+	case *packets.Packet_GuestLoginRequest:
+		c.handleGuestLoginRequest(senderId, message)
+	case *packets.Packet_ClaimGuestRequest:
+		c.handleClaimGuestRequest(senderId, message)
 	}
 }
 
@@ -71,40 +108,265 @@ func (c *Connected) handleLoginRequest(senderId uint64, message *packets.Packet_
 		return
 	}
 
-	user, err := c.queries.GetUserByUsername(c.dbCtx, strings.ToLower(username))
+	usernameKey := auth.UsernameKey(username)
+	if c.lockedOut(usernameKey) {
+		return
+	}
+
+	// Unknown usernames count as failures too, so they get locked out just the same
+	failLogin := func() {
+		c.failPassword(usernameKey)
+		c.client.SocketSend(genericFallMessage)
+	}
+
+	user, err := c.queries.GetUserByUsername(c.dbCtx, usernameKey)
 	if err != nil {
 		c.logger.Printf("Error getting user %s: %v", username, err)
-		c.client.SocketSend(genericFallMessage)
+		failLogin()
 		return
 	}
 
 	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password))
 	if err != nil {
 		c.logger.Printf("User entered wrong password: %s", username)
-		c.client.SocketSend(genericFallMessage)
+		failLogin()
 		return
 	}
 
+	c.passwordAccepted(user.Username)
+
+	if err := c.logIn(user.ID, ""); err != nil {
+		c.logger.Printf("Error getting player for user %s: %v", username, err)
+		c.client.SocketSend(genericFallMessage)
+		return
+	}
 	c.logger.Printf("User %s logged in successfully", username)
+
+	c.startSession()
+}
+
+// Logs in with a token from an earlier password login instead
+func (c *Connected) handleTokenLoginRequest(senderId uint64, message *packets.Packet_TokenLoginRequest) {
+	if senderId != c.client.Id() {
+		c.logger.Printf("Received token login message from another client (Id %d)", senderId)
+		return
+	}
+
+	genericFailMessage := packets.NewDenyResponse("Your session has expired - please log in again")
+
+	claims, err := c.client.Tokens().Verify(message.TokenLoginRequest.Token)
+	if err != nil {
+		c.logger.Printf("Rejected session token: %v", err)
+		c.client.SocketSend(genericFailMessage)
+		return
+	}
+
+	// The token checks out, but the session may have been logged out since
+	session, err := c.queries.GetSession(c.dbCtx, claims.SessionId)
+	if err != nil || session.UserID != claims.UserId {
+		c.logger.Printf("No session %s for user %d: %v", claims.SessionId, claims.UserId, err)
+		c.client.SocketSend(genericFailMessage)
+		return
+	}
+
+	if err := c.logIn(claims.UserId, claims.SessionId); err != nil {
+		c.logger.Printf("Error getting player for user %d: %v", claims.UserId, err)
+		c.client.SocketSend(genericFailMessage)
+		return
+	}
+	c.logger.Printf("User %d logged in with session %s", claims.UserId, claims.SessionId)
+}
+
+// Turns password guessers away before any time is spent hashing their guesses. Tells the client
+// why if it is locked out.
+func (c *Connected) lockedOut(username string) bool {
+	address := c.client.RemoteAddress()
+	lockedFor, err := c.client.LoginGuard().LockedFor(c.dbCtx, username, address)
+	if err != nil {
+		c.logger.Printf("Error checking login attempts for user %s: %v", username, err)
+		c.client.SocketSend(packets.NewDenyResponse("Error logging in (internal server error) - please try again later"))
+		return true
+	}
+	if lockedFor > 0 {
+		c.logger.Printf("Login to %s from %s is locked out for another %v", username, address, lockedFor)
+		c.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf(
+			"Too many failed login attempts - please try again in %v", lockedFor.Round(time.Second),
+		)))
+		return true
+	}
+	return false
+}
+
+func (c *Connected) failPassword(username string) {
+	if err := c.client.LoginGuard().Fail(c.dbCtx, username, c.client.RemoteAddress()); err != nil {
+		c.logger.Printf("Error recording failed login for user %s: %v", username, err)
+	}
+}
+
+func (c *Connected) passwordAccepted(username string) {
+	if err := c.client.LoginGuard().Succeed(c.dbCtx, username); err != nil {
+		c.logger.Printf("Error clearing failed logins for user %s: %v", username, err)
+	}
+}
+
+// Loads the user's player and logs in as it
+func (c *Connected) logIn(userId int32, sessionId string) error {
+	player, err := c.queries.GetPlayerByUserID(c.dbCtx, userId)
+	if err != nil {
+		return err
+	}
+
+	c.loggedIn(&objects.Player{
+		Name:      player.Name,
+		DbId:      player.ID,
+		UserId:    userId,
+		SessionId: sessionId,
+		BestScore: player.BestScore,
+		Color:     int32(player.Color),
+	})
+	return nil
+}
+
+// Lets the client pick which room to play in as the player
+func (c *Connected) loggedIn(player *objects.Player) {
+	c.player = player
 	c.client.SocketSend(packets.NewOkResponse())
 
-	player, err := c.queries.GetPlayerByUserID(c.dbCtx, user.ID)
+	// Lets the client get back into its game if the connection drops
+	token := rand.Text()
+	c.client.SetResumeToken(token)
+	c.client.SocketSend(packets.NewResumeToken(token))
+
+	c.sendRoomList()
+}
 
+// Remembers the login, so the client can come back with a token rather than the password. Logging
+// in still works if this fails, it just won't be remembered.
+func (c *Connected) startSession() {
+	token, claims := c.client.Tokens().Issue(c.player.UserId)
+	err := c.queries.CreateSession(c.dbCtx, db.CreateSessionParams{
+		ID:        claims.SessionId,
+		UserID:    claims.UserId,
+		ExpiresAt: claims.ExpiresAt,
+	})
 	if err != nil {
-		c.logger.Printf("Error getting player for user %s: %v", username, err)
-		c.client.SocketSend(genericFallMessage)
+		c.logger.Printf("Error saving session for user %d: %v", claims.UserId, err)
 		return
 	}
 
-	// SetState in goroutine to avoid blocking Hub
-	go c.client.SetState(&InGame{
-		player: &objects.Player{
-			Name:      player.Name,
-			DbId:      player.ID,
-			BestScore: player.BestScore,
-			Color:     int32(player.Color),
-		},
+	c.player.SessionId = claims.SessionId
+	c.client.SocketSend(packets.NewSessionToken(token, claims.ExpiresAt.Unix()))
+}
+
+func (c *Connected) handleChangePasswordRequest(senderId uint64, message *packets.Packet_ChangePasswordRequest) {
+	if senderId != c.client.Id() {
+		return
+	}
+
+	if c.player == nil {
+		c.client.SocketSend(packets.NewDenyResponse("You are not logged in"))
+		return
+	}
+	if c.player.Guest {
+		c.client.SocketSend(packets.NewDenyResponse("Guests don't have a password - claim an account first"))
+		return
+	}
+
+	genericFailMessage := packets.NewDenyResponse("Error changing password (internal server error) - please try again later")
+
+	user, err := c.queries.GetUserByID(c.dbCtx, c.player.UserId)
+	if err != nil {
+		c.logger.Printf("Error getting user %d: %v", c.player.UserId, err)
+		c.client.SocketSend(genericFailMessage)
+		return
+	}
+
+	// Somebody at an unattended keyboard gets no more guesses than anyone else
+	if c.lockedOut(user.Username) {
+		return
+	}
+	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(message.ChangePasswordRequest.OldPassword))
+	if err != nil {
+		c.logger.Printf("User entered wrong password changing it: %s", user.Username)
+		c.failPassword(user.Username)
+		c.client.SocketSend(packets.NewDenyResponse("Incorrect password"))
+		return
+	}
+	c.passwordAccepted(user.Username)
+
+	newPassword := message.ChangePasswordRequest.NewPassword
+	if err := validatePassword(newPassword, user.Username); err != nil {
+		c.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Invalid password: %v", err)))
+		return
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		c.logger.Printf("Failed to hash password: %s", user.Username)
+		c.client.SocketSend(genericFailMessage)
+		return
+	}
+
+	err = c.queries.UpdateUserPassword(c.dbCtx, db.UpdateUserPasswordParams{
+		PasswordHash: string(passwordHash),
+		ID:           user.ID,
 	})
+	if err != nil {
+		c.logger.Printf("Failed to update password for user %s: %v", user.Username, err)
+		c.client.SocketSend(genericFailMessage)
+		return
+	}
+
+	// Whoever else was logged in with the old password is logged out. This client gets a new session.
+	if err := c.queries.DeleteUserSessions(c.dbCtx, user.ID); err != nil {
+		c.logger.Printf("Error revoking sessions for user %s: %v", user.Username, err)
+	}
+
+	c.logger.Printf("User %s changed their password", user.Username)
+	c.client.SocketSend(packets.NewOkResponse())
+	c.startSession()
+}
+
+// Revokes the session the client logged in with, or all of the account's sessions, and goes back
+// to the login screen
+func (c *Connected) handleLogoutRequest(senderId uint64, message *packets.Packet_LogoutRequest) {
+	if senderId != c.client.Id() {
+		return
+	}
+
+	if c.player == nil {
+		c.client.SocketSend(packets.NewDenyResponse("You are not logged in"))
+		return
+	}
+
+	var err error
+	if message.LogoutRequest.Everywhere {
+		err = c.queries.DeleteUserSessions(c.dbCtx, c.player.UserId)
+	} else if c.player.SessionId != "" {
+		err = c.queries.DeleteSession(c.dbCtx, c.player.SessionId)
+	}
+	if err != nil {
+		c.logger.Printf("Error revoking sessions for user %d: %v", c.player.UserId, err)
+		c.client.SocketSend(packets.NewDenyResponse("Error logging out (internal server error) - please try again later"))
+		return
+	}
+
+	c.logger.Printf("User %d logged out", c.player.UserId)
+	c.player = nil
+	c.client.SetResumeToken("")
+	c.client.SocketSend(packets.NewOkResponse())
+}
+
+// Swaps this connection in for the client's dropped one. The resumed state takes it from there.
+func (c *Connected) handleResumeRequest(senderId uint64, message *packets.Packet_ResumeRequest) {
+	if senderId != c.client.Id() {
+		return
+	}
+
+	if !c.client.Resume(message.ResumeRequest.Token) {
+		c.logger.Println("Nothing to resume with the token given")
+		c.client.SocketSend(packets.NewDenyResponse("Your session has expired - please log in again"))
+	}
 }
 
 func (c *Connected) handleRegisterRequest(senderId uint64, message *packets.Packet_RegisterRequest) {
@@ -113,38 +375,58 @@ func (c *Connected) handleRegisterRequest(senderId uint64, message *packets.Pack
 		return
 	}
 
-	username := strings.ToLower(message.RegisterRequest.Username)
-	err := validateUsername(message.RegisterRequest.Username)
+	request := message.RegisterRequest
+	if _, _, ok := c.createAccount(request.Username, request.Password, int32(request.Color)); !ok {
+		return
+	}
+
+	c.logger.Printf("User %s registered successfully", auth.UsernameKey(request.Username))
+	c.client.SocketSend(packets.NewOkResponse())
+}
+
+// Adds the user and their player to the database. Tells the client why if it can't.
+func (c *Connected) createAccount(name, password string, color int32) (db.User, db.Player, bool) {
+	name = auth.NormalizeUsername(name)
+	username := strings.ToLower(name)
+	err := validateUsername(name)
 	if err != nil {
 		reason := fmt.Sprintf("Invalid username: %v", err)
 		c.logger.Println(reason)
 		c.client.SocketSend(packets.NewDenyResponse(reason))
-		return
+		return db.User{}, db.Player{}, false
 	}
 
-	err = validatePassword(message.RegisterRequest.Password)
+	err = validatePassword(password, name)
 	if err != nil {
 		reason := fmt.Sprintf("Invalid password: %v", err)
 		c.logger.Println(reason)
 		c.client.SocketSend(packets.NewDenyResponse(reason))
-		return
+		return db.User{}, db.Player{}, false
 	}
 
 	_, err = c.queries.GetUserByUsername(c.dbCtx, username)
 	if err == nil {
 		c.logger.Printf("User already exists: %s", username)
 		c.client.SocketSend(packets.NewDenyResponse("User already exists"))
-		return
+		return db.User{}, db.Player{}, false
+	}
+
+	// A name which passes for somebody else's would let its owner impersonate them
+	skeleton := sql.NullString{String: auth.Skeleton(name), Valid: true}
+	if lookalike, err := c.queries.GetPlayerByNameSkeleton(c.dbCtx, skeleton); err == nil {
+		c.logger.Printf("Username %s looks like existing player %s", username, lookalike.Name)
+		c.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Invalid username: too like the existing player %s", lookalike.Name)))
+		return db.User{}, db.Player{}, false
 	}
 
 	genericFailMessage := packets.NewDenyResponse("Error registering user (internal server error) - please try again later")
 
 	// Add new user
-	passwordHash, err := bcrypt.GenerateFromPassword([]byte(message.RegisterRequest.Password), bcrypt.DefaultCost)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
 		c.logger.Printf("Failed to hash password: %s", username)
 		c.client.SocketSend(genericFailMessage)
-		return
+		return db.User{}, db.Player{}, false
 	}
 
 	user, err := c.queries.CreateUser(c.dbCtx, db.CreateUserParams{
@@ -155,23 +437,92 @@ func (c *Connected) handleRegisterRequest(senderId uint64, message *packets.Pack
 	if err != nil {
 		c.logger.Printf("Failed to create user %s: %v", username, err)
 		c.client.SocketSend(genericFailMessage)
-		return
+		return db.User{}, db.Player{}, false
 	}
 
-	_, err = c.queries.CreatePlayer(c.dbCtx, db.CreatePlayerParams{
-		UserID: user.ID,
-		Name:   message.RegisterRequest.Username,
-		Color:  int32(message.RegisterRequest.Color),
+	player, err := c.queries.CreatePlayer(c.dbCtx, db.CreatePlayerParams{
+		UserID:       user.ID,
+		Name:         name,
+		Color:        color,
+		NameSkeleton: skeleton,
 	})
 
 	if err != nil {
+		// Nobody can log in to a user without a player, so it mustn't keep the name taken
+		if err := c.queries.DeleteUser(c.dbCtx, user.ID); err != nil {
+			c.logger.Printf("Failed to delete user %s left without a player: %v", username, err)
+		}
+
+		// Somebody else may have registered a look-alike since it was checked above
+		if db.IsUniqueViolation(err, db.PlayerNameSkeletonIndex) {
+			c.logger.Printf("Username %s looks like a player registered at the same time", username)
+			c.client.SocketSend(packets.NewDenyResponse("Invalid username: too like an existing player"))
+			return db.User{}, db.Player{}, false
+		}
+
 		c.logger.Printf("Failed to create player for user %s: %v", username, err)
 		c.client.SocketSend(genericFailMessage)
+		return db.User{}, db.Player{}, false
+	}
+
+	return user, player, true
+}
+
+// Lets the client play straight away under a made up name. Nothing about a guest is saved unless
+// it claims an account.
+func (c *Connected) handleGuestLoginRequest(senderId uint64, _ *packets.Packet_GuestLoginRequest) {
+	if senderId != c.client.Id() {
 		return
 	}
 
-	c.logger.Printf("User %s registered successfully", username)
+	if c.player != nil {
+		c.client.SocketSend(packets.NewDenyResponse("You are already logged in"))
+		return
+	}
+
+	// Client IDs are never reused while the server is up, so neither are guest names
+	c.logger.Println("Playing as a guest")
+	c.loggedIn(&objects.Player{
+		Name:  fmt.Sprintf("Guest%d", c.client.Id()),
+		Color: int32(mathrand.Uint32() | 0xff), // Random RGBA color, fully opaque
+		Guest: true,
+	})
+}
+
+// Registers an account for the guest, keeping the best score it has made so far
+func (c *Connected) handleClaimGuestRequest(senderId uint64, message *packets.Packet_ClaimGuestRequest) {
+	if senderId != c.client.Id() {
+		return
+	}
+
+	if c.player == nil || !c.player.Guest {
+		c.client.SocketSend(packets.NewDenyResponse("Only guests can claim an account"))
+		return
+	}
+
+	request := message.ClaimGuestRequest
+	user, player, ok := c.createAccount(request.Username, request.Password, c.player.Color)
+	if !ok {
+		return
+	}
+
+	if c.player.BestScore > 0 {
+		err := c.queries.UpdatePlayerBestScore(c.dbCtx, db.UpdatePlayerBestScoreParams{
+			BestScore: c.player.BestScore,
+			ID:        player.ID,
+		})
+		if err != nil {
+			c.logger.Printf("Error carrying over best score for user %s: %v", user.Username, err)
+		}
+	}
+
+	c.logger.Printf("%s claimed the account %s", c.player.Name, user.Username)
+	c.player.Name = player.Name
+	c.player.DbId = player.ID
+	c.player.UserId = user.ID
+	c.player.Guest = false
 	c.client.SocketSend(packets.NewOkResponse())
+	c.startSession()
 }
 
 func (c *Connected) handleHiscoreBoardRequest(senderId uint64, _ *packets.Packet_HiScoreBoardRequest) {
@@ -179,22 +530,171 @@ func (c *Connected) handleHiscoreBoardRequest(senderId uint64, _ *packets.Packet
 	go c.client.SetState(&BrowsingHiscores{})
 }
 
+func (c *Connected) handleRoomListRequest(_ uint64, _ *packets.Packet_RoomListRequest) {
+	c.sendRoomList()
+}
+
+func (c *Connected) sendRoomList() {
+	rooms := make([]*packets.RoomMessage, 0, c.client.Rooms().Len())
+	c.client.Rooms().ForEach(func(_ uint64, room *server.Room) {
+		// Private rooms can only be found with their code
+		if !room.IsPrivate() {
+			rooms = append(rooms, room.Info())
+		}
+	})
+	slices.SortFunc(rooms, func(a, b *packets.RoomMessage) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
+
+	c.client.SocketSend(packets.NewRoomList(rooms))
+}
+
+func (c *Connected) handleJoinRoomRequest(senderId uint64, message *packets.Packet_JoinRoomRequest) {
+	if senderId != c.client.Id() {
+		c.logger.Printf("Received join room message from another client (Id %d)", senderId)
+		return
+	}
+
+	if c.player == nil {
+		c.client.SocketSend(packets.NewDenyResponse("You must log in before joining a room"))
+		return
+	}
+
+	room, exists := c.client.Rooms().Get(message.JoinRoomRequest.RoomId)
+	if !exists || room.IsPrivate() {
+		c.client.SocketSend(packets.NewDenyResponse("That room does not exist"))
+		return
+	}
+
+	c.enterRoom(room)
+}
+
+func (c *Connected) handleJoinByCodeRequest(senderId uint64, message *packets.Packet_JoinByCodeRequest) {
+	if senderId != c.client.Id() {
+		c.logger.Printf("Received join by code message from another client (Id %d)", senderId)
+		return
+	}
+
+	if c.player == nil {
+		c.client.SocketSend(packets.NewDenyResponse("You must log in before joining a room"))
+		return
+	}
+
+	room, exists := server.RoomByCode(c.client.Rooms(), message.JoinByCodeRequest.Code)
+	if !exists {
+		c.client.SocketSend(packets.NewDenyResponse("No room has that code"))
+		return
+	}
+
+	c.enterRoom(room)
+}
+
+func (c *Connected) handleCreateRoomRequest(senderId uint64, message *packets.Packet_CreateRoomRequest) {
+	if senderId != c.client.Id() {
+		c.logger.Printf("Received create room message from another client (Id %d)", senderId)
+		return
+	}
+
+	if c.player == nil {
+		c.client.SocketSend(packets.NewDenyResponse("You must log in before creating a room"))
+		return
+	}
+
+	name := message.CreateRoomRequest.Name
+	if name == "" {
+		name = fmt.Sprintf("%s's room", c.player.Name)
+	}
+	if err := validateRoomName(name); err != nil {
+		c.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Invalid room name: %v", err)))
+		return
+	}
+
+	maxPlayers := int(message.CreateRoomRequest.MaxPlayers)
+	if maxPlayers == 0 {
+		maxPlayers = server.MaxPrivateRoomPlayers
+	}
+	if err := validateMaxPlayers(maxPlayers); err != nil {
+		c.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Invalid max players: %v", err)))
+		return
+	}
+
+	room := c.client.OpenPrivateRoom(server.PrivateRoomConfig(name, maxPlayers))
+	c.logger.Printf("Opened private room %d (%s) with code %s", room.Id, room.Name, room.Code)
+	c.client.SocketSend(packets.NewRoomCreated(room.Id, room.Code))
+
+	c.enterRoom(room)
+}
+
+// Takes the logged in player into the room to play
+func (c *Connected) enterRoom(room *server.Room) {
+	if err := c.client.JoinRoom(room, c.player); err != nil {
+		c.logger.Printf("Failed to join room %d: %v", room.Id, err)
+		c.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Could not join %s: %v", room.Name, err)))
+		return
+	}
+
+	c.client.SocketSend(packets.NewOkResponse())
+
+	// SetState in goroutine to avoid blocking Hub
+	go c.client.SetState(&InGame{
+		player: c.player,
+	})
+}
+
+// Watching doesn't need an account, so this works whether or not the client has logged in
+func (c *Connected) handleSpectateRequest(senderId uint64, message *packets.Packet_SpectateRequest) {
+	if senderId != c.client.Id() {
+		c.logger.Printf("Received spectate message from another client (Id %d)", senderId)
+		return
+	}
+
+	room, exists := c.client.Rooms().Get(message.SpectateRequest.RoomId)
+	if !exists || room.IsPrivate() {
+		c.client.SocketSend(packets.NewDenyResponse("That room does not exist"))
+		return
+	}
+
+	c.client.WatchRoom(room)
+	c.client.SocketSend(packets.NewOkResponse())
+
+	// SetState in goroutine to avoid blocking Hub
+	go c.client.SetState(&Spectating{
+		player:   c.player,
+		followId: message.SpectateRequest.PlayerId,
+	})
+}
+
+// Expects the username to have been normalized already
 func validateUsername(username string) error {
-	if len(username) <= 0 {
-		return errors.New("empty")
+	if err := auth.CheckUsername(username); err != nil {
+		return err
+	}
+	if strings.HasPrefix(strings.ToLower(username), "guest") {
+		return errors.New("names starting with Guest are kept for guests")
 	}
-	if len(username) > 20 {
+	return nil
+}
+
+func validateRoomName(name string) error {
+	if len(name) > 30 {
 		return errors.New("too long")
 	}
-	if username != strings.TrimSpace(username) {
+	if name != strings.TrimSpace(name) {
 		return errors.New("leading or trailing whitespace")
 	}
 	return nil
 }
 
-func validatePassword(password string) error {
-	if len(password) == 0 {
-		return errors.New("password cannot be empty")
+func validateMaxPlayers(maxPlayers int) error {
+	if maxPlayers < 2 {
+		return errors.New("a room needs space for at least 2 players")
+	}
+	if maxPlayers > server.MaxPrivateRoomPlayers {
+		return fmt.Errorf("a private room can hold at most %d players", server.MaxPrivateRoomPlayers)
 	}
 	return nil
 }
+
+func validatePassword(password, username string) error {
+	return auth.DefaultPasswordPolicy.Check(password, username)
+}
@@ -0,0 +1,92 @@
+package states
+
+import (
+	"context"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"strings"
+	"testing"
+)
+
+// Stands in for a client with no database behind it, recording what it is sent. Anything else
+// panics, as does any query.
+type testClient struct {
+	server.ClientInterfacer
+	id          uint64
+	sent        []packets.Msg
+	broadcasted []packets.Msg
+	resumeAs    string
+	room        *server.Room
+}
+
+func (c *testClient) Id() uint64                     { return c.id }
+func (c *testClient) SocketSend(message packets.Msg) { c.sent = append(c.sent, message) }
+func (c *testClient) SetResumeToken(token string)    { c.resumeAs = token }
+func (c *testClient) Broadcast(message packets.Msg)  { c.broadcasted = append(c.broadcasted, message) }
+func (c *testClient) Room() *server.Room             { return c.room }
+
+func (c *testClient) SocketSendAs(message packets.Msg, _ uint64) {
+	c.sent = append(c.sent, message)
+}
+
+func (c *testClient) SharedGameObjects() *server.SharedGameObjects {
+	if c.room == nil {
+		return nil
+	}
+	return c.room.SharedGameObjects
+}
+
+func (c *testClient) Rooms() *objects.SharedCollection[*server.Room] {
+	return objects.NewSharedCollection[*server.Room]()
+}
+
+func (c *testClient) DbTx() *server.DbTx {
+	return &server.DbTx{Ctx: context.Background(), Queries: db.New(nil)}
+}
+
+// TestGuests tests playing without an account
+func TestGuests(t *testing.T) {
+	t.Run("Guests get a made up player with nothing in the database", func(t *testing.T) {
+		client := &testClient{id: 7}
+		connected := &Connected{}
+		connected.SetClient(client)
+
+		connected.handleGuestLoginRequest(7, &packets.Packet_GuestLoginRequest{})
+
+		player := connected.player
+		if player == nil || !player.Guest {
+			t.Fatalf("Expected to be logged in as a guest, got %+v", player)
+		}
+		if player.Name != "Guest7" || player.DbId != 0 || player.UserId != 0 {
+			t.Errorf("Expected an unsaved player named after the client, got %+v", player)
+		}
+		if _, ok := client.sent[0].(*packets.Packet_OkResponse); !ok {
+			t.Errorf("Expected the client to be told it got in, got %T", client.sent[0])
+		}
+		if client.resumeAs == "" {
+			t.Error("Expected guests to be able to resume a dropped game too")
+		}
+	})
+
+	t.Run("Guest scores are kept but not saved", func(t *testing.T) {
+		game := &InGame{player: &objects.Player{Radius: massToRad(250), Guest: true}}
+		game.SetClient(&testClient{id: 7})
+
+		// Would panic if it touched the database
+		game.syncPlayerBestScore()
+
+		if game.player.BestScore != 250 {
+			t.Errorf("Expected the guest's best score to be kept for claiming an account, got %d", game.player.BestScore)
+		}
+	})
+
+	t.Run("Guest names can't be registered", func(t *testing.T) {
+		for _, name := range []string{"Guest7", "guest", "GUESTY"} {
+			if err := validateUsername(name); err == nil || !strings.Contains(err.Error(), "guest") {
+				t.Errorf("Expected %q to be kept for guests, got %v", name, err)
+			}
+		}
+	})
+}
@@ -12,43 +12,12 @@ import (
 	"time"
 )
 
-func (g *InGame) getSpore(sporeId uint64) (*objects.Spore, error) {
-	spore, exists := g.client.SharedGameObjects().Spores.Get(sporeId)
-	if !exists {
-		return nil, fmt.Errorf("spore with ID %d does not exist", sporeId)
-	}
-	return spore, nil
-}
-
-func (g *InGame) validatePlayerCloseToObject(objX, objY, objRadius, buffer float64) error {
-	realDX := g.player.X - objX
-	realDY := g.player.Y - objY
-	realDistSq := realDX*realDX + realDY*realDY
-
-	thresholdDist := g.player.Radius + buffer + objRadius
-	thresholdDistSq := thresholdDist * thresholdDist
-
-	if realDistSq > thresholdDistSq {
-		return fmt.Errorf("player is too far from the object (distSq: %f, thresholdSq: %f)", realDistSq, thresholdDistSq)
-	}
-	return nil
-}
-
-func (g *InGame) validatePlayerDropCooldown(spore *objects.Spore, buffer float64) error {
-	minAcceptableDistance := spore.Radius + g.player.Radius + buffer
-	minAcceptableTime := time.Duration(minAcceptableDistance/g.player.Speed*1000) * time.Millisecond
-	if spore.DroppedBy == g.player && time.Since(spore.DroppedAt) < minAcceptableTime {
-		return fmt.Errorf("player dropped the spore too recently (time since drop: %v, min acceptable time: %v", time.Since(spore.DroppedAt), minAcceptableTime)
-	}
-	return nil
-}
-
 func radToMass(radius float64) float64 {
-	return math.Pi * radius * radius
+	return objects.RadToMass(radius)
 }
 
 func massToRad(mass float64) float64 {
-	return math.Sqrt(mass / math.Pi)
+	return objects.MassToRad(mass)
 }
 
 func (g *InGame) nextRadius(massDiff float64) float64 {
@@ -57,91 +26,6 @@ func (g *InGame) nextRadius(massDiff float64) float64 {
 	return massToRad(newMass)
 }
 
-func (g *InGame) playerUpdateLoop(ctx context.Context) {
-	const delta float64 = 0.05
-	ticker := time.NewTicker(time.Duration(delta*1000) * time.Millisecond)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			g.syncPlayer(delta)
-		case <-ctx.Done():
-			return
-		}
-	}
-}
-
-func (g *InGame) syncPlayer(delta float64) {
-	oldX, oldY := g.player.X, g.player.Y
-
-	// Calculate new position based on velocity
-	newX := g.player.X + g.player.Speed*math.Cos(g.player.Direction)*delta
-	newY := g.player.Y + g.player.Speed*math.Sin(g.player.Direction)*delta
-
-	// Rubber-band boundary enforcement
-	// Creates a soft wall that pushes back with increasing force
-	buffer := g.player.Radius
-	rubberBandZone := 200.0 // Distance from boundary where rubber-band starts
-
-	// X-axis rubber-banding
-	minXBound := objects.MinX + buffer
-	maxXBound := objects.MaxX - buffer
-	if newX < minXBound {
-		// Hard clamp at boundary
-		newX = minXBound
-	} else if newX < minXBound+rubberBandZone {
-		// Soft zone: apply resistance
-		distanceIntoBoundary := minXBound + rubberBandZone - newX
-		resistance := distanceIntoBoundary / rubberBandZone // 0 to 1
-		pushBack := resistance * resistance * g.player.Speed * delta * 2
-		newX += pushBack
-	}
-
-	if newX > maxXBound {
-		newX = maxXBound
-	} else if newX > maxXBound-rubberBandZone {
-		distanceIntoBoundary := newX - (maxXBound - rubberBandZone)
-		resistance := distanceIntoBoundary / rubberBandZone
-		pushBack := resistance * resistance * g.player.Speed * delta * 2
-		newX -= pushBack
-	}
-
-	// Y-axis rubber-banding
-	minYBound := objects.MinY + buffer
-	maxYBound := objects.MaxY - buffer
-	if newY < minYBound {
-		newY = minYBound
-	} else if newY < minYBound+rubberBandZone {
-		distanceIntoBoundary := minYBound + rubberBandZone - newY
-		resistance := distanceIntoBoundary / rubberBandZone
-		pushBack := resistance * resistance * g.player.Speed * delta * 2
-		newY += pushBack
-	}
-
-	if newY > maxYBound {
-		newY = maxYBound
-	} else if newY > maxYBound-rubberBandZone {
-		distanceIntoBoundary := newY - (maxYBound - rubberBandZone)
-		resistance := distanceIntoBoundary / rubberBandZone
-		pushBack := resistance * resistance * g.player.Speed * delta * 2
-		newY -= pushBack
-	}
-
-	// Update player position (with rubber-band applied)
-	g.player.X = newX
-	g.player.Y = newY
-
-	// Debug: Log first position update
-	if oldX == 0 && oldY == 0 {
-		g.logger.Printf("First position update: (%.2f, %.2f) -> (%.2f, %.2f)", oldX, oldY, newX, newY)
-	}
-
-	updatePacket := packets.NewPlayer(g.client.Id(), g.player)
-	g.client.Broadcast(updatePacket)
-	g.client.SocketSend(updatePacket)
-}
-
 func (g *InGame) bestScoreSyncLoop(ctx context.Context) {
 	ticker := time.NewTicker(5 * time.Second)
 	defer ticker.Stop()
@@ -157,9 +41,20 @@ func (g *InGame) bestScoreSyncLoop(ctx context.Context) {
 }
 
 func (g *InGame) syncPlayerBestScore() {
-	currentScore := int32(math.Round(radToMass(g.player.Radius)))
+	// Bots don't belong on the hiscore table
+	if g.player.IsBot {
+		return
+	}
+
+	// Decay means the player may have been bigger earlier on
+	currentScore := int32(math.Round(max(g.player.Mass(), g.player.PeakMass)))
 	if currentScore > g.player.BestScore {
 		g.player.BestScore = currentScore
+
+		// Guests have nowhere to save it, but keep it in case they claim an account
+		if g.player.Guest {
+			return
+		}
 		err := g.client.DbTx().Queries.UpdatePlayerBestScore(g.client.DbTx().Ctx, db.UpdatePlayerBestScoreParams{
 			ID:        g.player.DbId,
 			BestScore: g.player.BestScore,
@@ -170,12 +65,24 @@ func (g *InGame) syncPlayerBestScore() {
 	}
 }
 
+// Minimum time between two mass ejections by the same player
+const ejectMassCooldown = 100 * time.Millisecond
+
 type InGame struct {
-	client                    server.ClientInterfacer
-	player                    *objects.Player
-	logger                    *log.Logger
-	cancelPlayerUpdateLoop    context.CancelFunc
-	cancelBestScoreSyncLoop   context.CancelFunc
+	client                  server.ClientInterfacer
+	player                  *objects.Player
+	logger                  *log.Logger
+	cancelBestScoreSyncLoop context.CancelFunc
+
+	// Objects the client currently knows about, i.e. those in or near its viewport
+	interest *areaOfInterest
+
+	lastEjectAt time.Time
+}
+
+// For clients which enter the game without going through Connected, such as bots
+func NewInGame(player *objects.Player) *InGame {
+	return &InGame{player: player}
 }
 
 func (g *InGame) Name() string {
@@ -186,26 +93,27 @@ func (g *InGame) SetClient(client server.ClientInterfacer) {
 	g.client = client
 	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), g.Name())
 	g.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
+	g.interest = newAreaOfInterest(client)
 }
 
 func (g *InGame) OnEnter() {
-	g.logger.Printf("Adding player %s to the shared collection", g.player.Name)
-	go g.client.SharedGameObjects().Players.Add(g.player, g.client.Id())
-
 	// Set the initial properties of the player BEFORE calculating spawn coords
-	g.player.Speed = 150.0
-	g.player.Radius = 20.0
-	g.player.X, g.player.Y = objects.SpawnCoords(g.player.Radius, g.client.SharedGameObjects().Players, nil)
+	room := g.client.Room()
+	g.player.Radius = objects.SpawnRadius
+	g.player.Cells = nil
+	g.player.PowerUps = nil
+	g.player.Speed = room.SpeedCurve.SpeedFor(objects.RadToMass(g.player.Radius))
+	g.player.Team = room.AssignTeam()
+	world := room.SharedGameObjects
+	g.player.X, g.player.Y = objects.SpawnCoords(g.player.Radius, room.Bounds(), world.Players, world.Viruses)
 
 	g.logger.Printf("Player spawned at position (%.2f, %.2f) with radius %.2f", g.player.X, g.player.Y, g.player.Radius)
 
-	// Send game boundaries to the client so it can enforce them locally
-	g.client.SocketSend(packets.NewGameBounds(objects.MinX, objects.MaxX, objects.MinY, objects.MaxY))
-
-	// Send the player's initial state to the client
-	g.client.SocketSend(packets.NewPlayer(g.client.Id(), g.player))
+	// Only add the player once it is fully initialized, since the world tick starts moving it right away
+	g.logger.Printf("Adding player %s to the shared collection", g.player.Name)
+	g.client.SharedGameObjects().Players.Add(g.player, g.client.Id())
 
-	go g.sendInitialSpores(50, 50*time.Millisecond)
+	g.sendInitialState()
 
 	// Start background loop to sync best scores to database every 5 seconds
 	ctx, cancel := context.WithCancel(context.Background())
@@ -213,34 +121,39 @@ func (g *InGame) OnEnter() {
 	go g.bestScoreSyncLoop(ctx)
 }
 
-func (g *InGame) sendInitialSpores(batchSize int, delay time.Duration) {
-	sporesBatch := make(map[uint64]*objects.Spore, batchSize)
-
-	g.client.SharedGameObjects().Spores.ForEach(func(sporeId uint64, spore *objects.Spore) {
-		sporesBatch[sporeId] = spore
+func (g *InGame) sendInitialState() {
+	// Send game boundaries to the client so it can enforce them locally
+	bounds := g.client.Room().Bounds()
+	g.client.SocketSend(packets.NewGameBounds(bounds.MinX, bounds.MaxX, bounds.MinY, bounds.MaxY))
 
-		if len(sporesBatch) >= batchSize {
-			g.client.SocketSend(packets.NewSporeBatch(sporesBatch))
-			sporesBatch = make(map[uint64]*objects.Spore, batchSize)
-			time.Sleep(delay)
-		}
-	})
+	// Send the player's initial state to the client. Everything else it learns about through world
+	// updates as objects come into view.
+	g.interest.players.Add(g.client.Id())
+	g.client.SocketSend(packets.NewPlayer(g.client.Id(), g.player))
+}
 
-	if len(sporesBatch) > 0 {
-		g.client.SocketSend(packets.NewSporeBatch(sporesBatch))
-	}
+// The player stays in the world, standing still, until the client comes back or is given up on
+func (g *InGame) OnConnectionLost() {
+	g.logger.Println("Connection lost, freezing the player")
+	g.player.SetFrozen(true)
+}
 
+// The resumed client starts over with a fresh area of interest, so it is sent everything again
+func (g *InGame) OnConnectionResumed() {
+	g.logger.Println("Connection resumed")
+	g.player.SetFrozen(false)
+	g.sendInitialState()
 }
 
 func (g *InGame) OnExit() {
-	if g.cancelPlayerUpdateLoop != nil {
-		g.cancelPlayerUpdateLoop()
-	}
 	if g.cancelBestScoreSyncLoop != nil {
 		g.cancelBestScoreSyncLoop()
 	}
 
-	g.client.SharedGameObjects().Players.Remove(g.client.Id())
+	// Leaving the room takes the player out of it, and the client may have left already
+	if world := g.client.SharedGameObjects(); world != nil {
+		world.Players.Remove(g.client.Id())
+	}
 	// Final sync to ensure best score is saved before exiting
 	g.syncPlayerBestScore()
 }
@@ -261,6 +174,38 @@ func (g *InGame) HandleMessage(senderId uint64, message packets.Msg) {
 		g.handleSpore(senderId, message)
 	case *packets.Packet_Disconnect:
 		g.handleDisconnect(senderId, message)
+	case *packets.Packet_WorldUpdate:
+		g.handleWorldUpdate(senderId, message)
+	case *packets.Packet_LeaveRoomRequest:
+		g.handleLeaveRoomRequest(senderId, message)
+	case *packets.Packet_EjectMass:
+		g.handleEjectMass(senderId, message)
+	case *packets.Packet_Split:
+		g.handleSplit(senderId, message)
+	case *packets.Packet_Virus:
+		g.handleVirus(senderId, message)
+	case *packets.Packet_VirusConsumed:
+		g.handleVirusConsumed(senderId, message)
+	case *packets.Packet_PowerUp:
+		g.handlePowerUp(senderId, message)
+	case *packets.Packet_PowerUpConsumed:
+		g.handlePowerUpConsumed(senderId, message)
+	case *packets.Packet_RoundCountdown, *packets.Packet_RoundEnd, *packets.Packet_GameBounds, *packets.Packet_TeamScores:
+		g.client.SocketSendAs(message, senderId)
+	case *packets.Packet_Leaderboard:
+		forwardLeaderboard(g.client, senderId, message, g.client.Id())
+	case *packets.Packet_RoomOwner:
+		g.client.SocketSendAs(message, senderId)
+	case *packets.Packet_Kicked:
+		g.handleKicked(senderId, message)
+	case *packets.Packet_KickRequest:
+		g.handleKickRequest(senderId, message)
+	case *packets.Packet_MuteRequest:
+		g.handleMuteRequest(senderId, message)
+	case *packets.Packet_CloseRoomRequest:
+		g.handleCloseRoomRequest(senderId, message)
+	case *packets.Packet_SetMaxPlayersRequest:
+		g.handleSetMaxPlayersRequest(senderId, message)
 	}
 }
 
@@ -269,11 +214,21 @@ func (g *InGame) handlePlayer(senderId uint64, message *packets.Packet_Player) {
 		g.logger.Println("Received player message from out own client, ignoring")
 		return
 	}
-	g.client.SocketSendAs(message, senderId)
+
+	g.interest.forwardPlayer(senderId, message, objects.ViewportFor(g.player))
 }
 
 func (g *InGame) handleChat(senderId uint64, message *packets.Packet_Chat) {
 	if senderId == g.client.Id() {
+		room := g.client.Room()
+		if room == nil {
+			return
+		}
+		// Only the sender hears about messages the room's moderation turns away
+		if err := room.CheckChat(senderId, message.Chat.Msg); err != nil {
+			g.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Message not sent: %v", err)))
+			return
+		}
 		g.client.Broadcast(message)
 	} else {
 		g.client.SocketSendAs(message, senderId)
@@ -285,121 +240,242 @@ func (g *InGame) handlePlayerDirection(senderId uint64, message *packets.Packet_
 		return
 	}
 
-	g.player.Direction = message.PlayerDirection.Direction
+	// The hub's world tick picks up the new direction on its next step
+	g.player.Steer(message.PlayerDirection.Direction)
+}
 
-	if g.cancelPlayerUpdateLoop == nil {
-		g.logger.Println("Starting player update loop")
-		ctx, cancel := context.WithCancel(context.Background())
-		g.cancelPlayerUpdateLoop = cancel
-		go g.playerUpdateLoop(ctx)
+// Consumption is detected by the hub's world tick, so the only messages acted on here are the
+// hub's. Claims from our own client are ignored - the client only predicts consumption locally.
+func (g *InGame) handleSporeConsumed(senderId uint64, message *packets.Packet_SporeConsumed) {
+	if senderId == g.client.Id() {
+		return
 	}
+
+	g.interest.forwardSporeConsumed(senderId, message)
 }
 
-func (g *InGame) handleSporeConsumed(senderId uint64, message *packets.Packet_SporeConsumed) {
-	if senderId != g.client.Id() {
-		g.client.SocketSendAs(message, senderId)
+func (g *InGame) handlePlayerConsumed(senderId uint64, message *packets.Packet_PlayerConsumed) {
+	if senderId == g.client.Id() {
 		return
 	}
 
-	errMsg := "Could not verify spore consumption: "
+	g.client.SocketSendAs(message, senderId)
+
+	if message.PlayerConsumed.PlayerId == g.client.Id() {
+		g.logger.Println("Player was consumed, respawning")
+		player := &objects.Player{
+			Name:      g.player.Name,
+			DbId:      g.player.DbId,
+			UserId:    g.player.UserId,
+			SessionId: g.player.SessionId,
+			BestScore: g.player.BestScore,
+			Color:     g.player.Color,
+			IsBot:     g.player.IsBot,
+			Guest:     g.player.Guest,
+		}
+		player.SetFrozen(g.player.Frozen())
+		// SetState in goroutine to avoid blocking Hub
+		go g.client.SetState(&InGame{player: player})
+	}
+}
+
+func (g *InGame) handleSpore(senderId uint64, message *packets.Packet_Spore) {
+	g.interest.forwardSpore(senderId, message, objects.ViewportFor(g.player))
+}
+
+func (g *InGame) handleVirus(senderId uint64, message *packets.Packet_Virus) {
+	g.interest.forwardVirus(senderId, message, objects.ViewportFor(g.player))
+}
+
+func (g *InGame) handleVirusConsumed(senderId uint64, message *packets.Packet_VirusConsumed) {
+	// Our own client never sees its player's pops here, and finds out from the next world update
+	g.interest.forwardVirusConsumed(senderId, message)
+}
+
+func (g *InGame) handlePowerUp(senderId uint64, message *packets.Packet_PowerUp) {
+	g.interest.forwardPowerUp(senderId, message, objects.ViewportFor(g.player))
+}
+
+// Our own client never sees its player's pickups here, and finds out what it gained from the next
+// world update
+func (g *InGame) handlePowerUpConsumed(senderId uint64, message *packets.Packet_PowerUpConsumed) {
+	g.interest.forwardPowerUpConsumed(senderId, message)
+}
 
-	sporeId := message.SporeConsumed.SporeId
-	spore, err := g.getSpore(sporeId)
-	if err != nil {
-		g.logger.Println(errMsg + err.Error())
+func (g *InGame) handleWorldUpdate(senderId uint64, message *packets.Packet_WorldUpdate) {
+	// Bots have nobody to send the update to, so there is no point working out what they can see
+	if g.player.IsBot {
 		return
 	}
 
-	// Large buffer to account for network lag + server tick delay
-	// Google Cloud: ~50-100ms RTT + 50ms tick + jitter = need generous buffer
-	// At speed 150: 100ms = 15 units, 200ms = 30 units
-	const validationBuffer = 100.0
-	err = g.validatePlayerCloseToObject(spore.X, spore.Y, spore.Radius, validationBuffer)
-	if err != nil {
-		g.logger.Println(errMsg + err.Error())
+	// The room hands updates to the clients it had when it sent them, so one can arrive after we left
+	world := g.client.SharedGameObjects()
+	if world == nil {
+		return
+	}
+	g.interest.forwardWorldUpdate(senderId, message, world, objects.ViewportFor(g.player), g.client.Id())
+}
+
+func (g *InGame) handleEjectMass(senderId uint64, _ *packets.Packet_EjectMass) {
+	if senderId != g.client.Id() {
 		return
 	}
 
-	err = g.validatePlayerDropCooldown(spore, validationBuffer)
-	if err != nil {
-		g.logger.Println(errMsg + err.Error())
+	if time.Since(g.lastEjectAt) < ejectMassCooldown {
 		return
 	}
 
-	sporeMass := radToMass(spore.Radius)
-	g.player.Radius = g.nextRadius(sporeMass)
+	// The world tick fires the spore and sends it out, and the shrunken player goes out with the
+	// next world update
+	g.player.Queue(objects.IntentEjectMass)
+	g.lastEjectAt = time.Now()
+}
 
-	go g.client.SharedGameObjects().Spores.Remove(sporeId)
+func (g *InGame) handleSplit(senderId uint64, _ *packets.Packet_Split) {
+	if senderId != g.client.Id() {
+		return
+	}
 
-	g.client.Broadcast(message)
+	// The world tick splits the player, and the new cells go out with the next world update
+	g.player.Queue(objects.IntentSplit)
 }
 
-func (g *InGame) handlePlayerConsumed(senderId uint64, message *packets.Packet_PlayerConsumed) {
+// Goes back to the room picker, staying logged in
+func (g *InGame) handleLeaveRoomRequest(senderId uint64, _ *packets.Packet_LeaveRoomRequest) {
 	if senderId != g.client.Id() {
-		g.client.SocketSendAs(message, senderId)
+		return
+	}
 
-		if message.PlayerConsumed.PlayerId == g.client.Id() {
-			log.Println("Player was consumed, respawning")
-			// SetState in goroutine to avoid blocking Hub
-			go g.client.SetState(&InGame{
-				player: &objects.Player{
-					Name: g.player.Name,
-				},
-			})
-		}
+	g.client.Broadcast(packets.NewDisconnect("left the room"))
+	// SetState in goroutine to avoid blocking Hub
+	go func() {
+		g.client.SetState(&Connected{player: g.player})
+		g.client.LeaveRoom()
+	}()
+}
 
+// Only the room itself can kick players out, so the message has to come from the server
+func (g *InGame) handleKicked(senderId uint64, message *packets.Packet_Kicked) {
+	if senderId != 0 {
 		return
 	}
 
-	errMsg := "Could not verify player consumption: "
+	g.logger.Printf("Removed from the room: %s", message.Kicked.Reason)
+	g.client.SocketSend(message)
+	g.client.Broadcast(packets.NewDisconnect("left the room"))
+	// SetState in goroutine to avoid blocking Hub
+	go func() {
+		g.client.SetState(&Connected{player: g.player})
+		g.client.LeaveRoom()
+	}()
+}
 
-	otherId := message.PlayerConsumed.PlayerId
-	other, err := g.getOtherPlayer(otherId)
-	if err != nil {
-		g.logger.Println(errMsg + err.Error())
+// The room our client is in, if it runs it. Tells the client off if it doesn't.
+func (g *InGame) ownedRoom() (*server.Room, bool) {
+	room := g.client.Room()
+	if room == nil {
+		return nil, false
+	}
+	if room.OwnerId() != g.client.Id() {
+		g.client.SocketSend(packets.NewDenyResponse("Only the room's owner can do that"))
+		return nil, false
+	}
+	return room, true
+}
+
+func (g *InGame) handleKickRequest(senderId uint64, message *packets.Packet_KickRequest) {
+	if senderId != g.client.Id() {
+		return
+	}
+	room, ok := g.ownedRoom()
+	if !ok {
 		return
 	}
 
-	ourMass := radToMass(g.player.Radius)
-	otherMass := radToMass(other.Radius)
-	if ourMass <= otherMass*1.5 {
-		g.logger.Printf(errMsg+"player not massive enough to consume the other player (our radius: %f, other radius: %f)", g.player.Radius, other.Radius)
+	targetId := message.KickRequest.PlayerId
+	if targetId == g.client.Id() {
+		g.client.SocketSend(packets.NewDenyResponse("Leave the room instead of kicking yourself"))
+		return
+	}
+	if !room.Kick(targetId, fmt.Sprintf("Kicked by %s", g.player.Name)) {
+		g.client.SocketSend(packets.NewDenyResponse("That player is not in the room"))
 		return
 	}
 
-	const validationBuffer = 100.0
-	err = g.validatePlayerCloseToObject(other.X, other.Y, other.Radius, validationBuffer)
-	if err != nil {
-		g.logger.Println(errMsg + err.Error())
+	g.logger.Printf("Kicked client %d from the room", targetId)
+	g.client.SocketSend(packets.NewOkResponse())
+}
+
+func (g *InGame) handleMuteRequest(senderId uint64, message *packets.Packet_MuteRequest) {
+	if senderId != g.client.Id() {
+		return
+	}
+	room, ok := g.ownedRoom()
+	if !ok {
 		return
 	}
 
-	g.player.Radius = g.nextRadius(otherMass)
+	targetId := message.MuteRequest.PlayerId
+	duration := time.Duration(message.MuteRequest.Seconds) * time.Second
+	if targetId == g.client.Id() {
+		g.client.SocketSend(packets.NewDenyResponse("You can't mute yourself"))
+		return
+	}
+	if duration > server.MaxMute {
+		g.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Players can be muted for at most %v", server.MaxMute)))
+		return
+	}
+	if !room.Mute(targetId, duration) {
+		g.client.SocketSend(packets.NewDenyResponse("That player is not in the room"))
+		return
+	}
 
-	go g.client.SharedGameObjects().Players.Remove(otherId)
+	g.logger.Printf("Muted client %d for %v", targetId, duration)
+	g.client.SocketSend(packets.NewOkResponse())
+}
 
-	g.client.Broadcast(message)
+func (g *InGame) handleCloseRoomRequest(senderId uint64, _ *packets.Packet_CloseRoomRequest) {
+	if senderId != g.client.Id() {
+		return
+	}
+	room, ok := g.ownedRoom()
+	if !ok {
+		return
+	}
+
+	// The room sends us back to the lobby along with everybody else
+	room.Close(fmt.Sprintf("%s closed the room", g.player.Name))
 }
 
-func (g *InGame) handleSpore(senderId uint64, message *packets.Packet_Spore) {
-	g.client.SocketSendAs(message, senderId)
+func (g *InGame) handleSetMaxPlayersRequest(senderId uint64, message *packets.Packet_SetMaxPlayersRequest) {
+	if senderId != g.client.Id() {
+		return
+	}
+	room, ok := g.ownedRoom()
+	if !ok {
+		return
+	}
+
+	maxPlayers := int(message.SetMaxPlayersRequest.MaxPlayers)
+	if err := validateMaxPlayers(maxPlayers); err != nil {
+		g.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Invalid max players: %v", err)))
+		return
+	}
+
+	room.SetMaxPlayers(maxPlayers)
+	g.client.SocketSend(packets.NewOkResponse())
 }
 
 func (g *InGame) handleDisconnect(senderId uint64, message *packets.Packet_Disconnect) {
 	if senderId == g.client.Id() {
 		g.client.Broadcast(message)
 		// SetState in goroutine to avoid blocking Hub
-		go g.client.SetState(&Connected{})
+		go func() {
+			g.client.SetState(&Connected{})
+			g.client.LeaveRoom()
+		}()
 	} else {
+		g.interest.players.Remove(senderId)
 		go g.client.SocketSendAs(message, senderId)
 	}
 }
-
-func (g *InGame) getOtherPlayer(otherId uint64) (*objects.Player, error) {
-	other, exists := g.client.SharedGameObjects().Players.Get(otherId)
-	if !exists {
-		return nil, fmt.Errorf("player with ID %d does not exist", otherId)
-	}
-
-	return other, nil
-}
@@ -0,0 +1,149 @@
+package states
+
+import (
+	"math"
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+)
+
+// Tracks which of the room's objects a client has been told about, so it is only ever sent what
+// is in or near its viewport
+type areaOfInterest struct {
+	client   server.ClientInterfacer
+	players  *objects.InterestSet
+	spores   *objects.InterestSet
+	viruses  *objects.InterestSet
+	powerUps *objects.InterestSet
+}
+
+func newAreaOfInterest(client server.ClientInterfacer) *areaOfInterest {
+	return &areaOfInterest{
+		client:   client,
+		players:  objects.NewInterestSet(),
+		spores:   objects.NewInterestSet(),
+		viruses:  objects.NewInterestSet(),
+		powerUps: objects.NewInterestSet(),
+	}
+}
+
+func (a *areaOfInterest) forwardPlayer(senderId uint64, message *packets.Packet_Player, viewport objects.Viewport) {
+	player := message.Player
+	if !viewport.Contains(player.X, player.Y, playerReach(player)) {
+		return
+	}
+	a.players.Add(player.Id)
+	a.client.SocketSendAs(message, senderId)
+}
+
+func (a *areaOfInterest) forwardSpore(senderId uint64, message *packets.Packet_Spore, viewport objects.Viewport) {
+	spore := message.Spore
+	if !viewport.Contains(spore.X, spore.Y, spore.Radius) {
+		return
+	}
+	a.spores.Add(spore.Id)
+	a.client.SocketSendAs(message, senderId)
+}
+
+func (a *areaOfInterest) forwardVirus(senderId uint64, message *packets.Packet_Virus, viewport objects.Viewport) {
+	virus := message.Virus
+	if !viewport.Contains(virus.X, virus.Y, virus.Radius) {
+		return
+	}
+	a.viruses.Add(virus.Id)
+	a.client.SocketSendAs(message, senderId)
+}
+
+func (a *areaOfInterest) forwardPowerUp(senderId uint64, message *packets.Packet_PowerUp, viewport objects.Viewport) {
+	powerUp := message.PowerUp
+	if !viewport.Contains(powerUp.X, powerUp.Y, powerUp.Radius) {
+		return
+	}
+	a.powerUps.Add(powerUp.Id)
+	a.client.SocketSendAs(message, senderId)
+}
+
+// Nothing to tell the client if it never knew about the spore
+func (a *areaOfInterest) forwardSporeConsumed(senderId uint64, message *packets.Packet_SporeConsumed) {
+	if a.spores.Has(message.SporeConsumed.SporeId) {
+		a.spores.Remove(message.SporeConsumed.SporeId)
+		a.client.SocketSendAs(message, senderId)
+	}
+}
+
+func (a *areaOfInterest) forwardVirusConsumed(senderId uint64, message *packets.Packet_VirusConsumed) {
+	if a.viruses.Has(message.VirusConsumed.VirusId) {
+		a.viruses.Remove(message.VirusConsumed.VirusId)
+		a.client.SocketSendAs(message, senderId)
+	}
+}
+
+func (a *areaOfInterest) forwardPowerUpConsumed(senderId uint64, message *packets.Packet_PowerUpConsumed) {
+	if a.powerUps.Has(message.PowerUpConsumed.PowerUpId) {
+		a.powerUps.Remove(message.PowerUpConsumed.PowerUpId)
+		a.client.SocketSendAs(message, senderId)
+	}
+}
+
+// Forwards the part of the world update the client can see, and tells it about any objects in
+// world entering or leaving the viewport since the last update. The player with ID alwaysVisible is
+// kept in the update wherever it is, so clients never lose track of their own player.
+func (a *areaOfInterest) forwardWorldUpdate(senderId uint64, message *packets.Packet_WorldUpdate, world *server.SharedGameObjects, viewport objects.Viewport, alwaysVisible uint64) {
+	visiblePlayerIds := make(map[uint64]struct{}, len(message.WorldUpdate.Players))
+	update := packets.FilterWorldUpdate(message.WorldUpdate, func(player *packets.PlayerMessage) bool {
+		if player.Id != alwaysVisible && !viewport.Contains(player.X, player.Y, playerReach(player)) {
+			return false
+		}
+		visiblePlayerIds[player.Id] = struct{}{}
+		return true
+	}, func(spore *packets.SporeMessage) bool {
+		// Sliding spores the client hasn't been told about yet arrive in the batch below instead
+		return a.spores.Has(spore.Id)
+	})
+	_, playersLeft := a.players.Update(visiblePlayerIds)
+	a.client.SocketSendAs(update, senderId)
+
+	sporesEntered, sporesLeft := updateInterest(a.spores, world.Spores.InViewport(viewport))
+	if len(sporesEntered) > 0 {
+		a.client.SocketSend(packets.NewSporeBatch(sporesEntered))
+	}
+
+	virusesEntered, virusesLeft := updateInterest(a.viruses, world.Viruses.InViewport(viewport))
+	if len(virusesEntered) > 0 {
+		a.client.SocketSend(packets.NewVirusesBatch(virusesEntered))
+	}
+
+	powerUpsEntered, powerUpsLeft := updateInterest(a.powerUps, world.PowerUps.InViewport(viewport))
+	if len(powerUpsEntered) > 0 {
+		a.client.SocketSend(packets.NewPowerUpsBatch(powerUpsEntered))
+	}
+
+	if len(playersLeft) > 0 || len(sporesLeft) > 0 || len(virusesLeft) > 0 || len(powerUpsLeft) > 0 {
+		a.client.SocketSend(packets.NewOutOfView(playersLeft, sporesLeft, virusesLeft, powerUpsLeft))
+	}
+}
+
+// Replaces the known set with the visible objects. Returns the visible objects which weren't known
+// before, and the IDs of known objects which are no longer visible.
+func updateInterest[T any](known *objects.InterestSet, visible map[uint64]T) (map[uint64]T, []uint64) {
+	visibleIds := make(map[uint64]struct{}, len(visible))
+	for id := range visible {
+		visibleIds[id] = struct{}{}
+	}
+	enteredIds, left := known.Update(visibleIds)
+
+	entered := make(map[uint64]T, len(enteredIds))
+	for _, id := range enteredIds {
+		entered[id] = visible[id]
+	}
+	return entered, left
+}
+
+// Distance from the center of a player's main body to the furthest edge of any of its cells
+func playerReach(player *packets.PlayerMessage) float64 {
+	reach := player.Radius
+	for _, cell := range player.Cells {
+		reach = max(reach, math.Hypot(cell.X-player.X, cell.Y-player.Y)+cell.Radius)
+	}
+	return reach
+}
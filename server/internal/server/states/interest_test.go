@@ -0,0 +1,39 @@
+package states
+
+import (
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"testing"
+)
+
+// TestMessagesAfterLeaving tests that room messages still on their way when the client leaves the
+// room are dropped rather than looked up in a room that is gone
+func TestMessagesAfterLeaving(t *testing.T) {
+	update := packets.NewWorldUpdate(map[uint64]*objects.Player{2: {Radius: 20}}, nil)
+
+	t.Run("Playing", func(t *testing.T) {
+		client := &testClient{id: 1}
+		game := &InGame{player: &objects.Player{Radius: 20}}
+		game.SetClient(client)
+
+		game.HandleMessage(0, update)
+		game.HandleMessage(1, packets.NewChat("hello"))
+
+		if len(client.sent) != 0 || len(client.broadcasted) != 0 {
+			t.Errorf("Expected nothing to be passed on, got %v and %v", client.sent, client.broadcasted)
+		}
+	})
+
+	t.Run("Spectating", func(t *testing.T) {
+		client := &testClient{id: 1}
+		spectating := &Spectating{}
+		spectating.SetClient(client)
+
+		spectating.HandleMessage(0, update)
+		spectating.HandleMessage(0, packets.NewSpore(3, &objects.Spore{Radius: 5}))
+
+		if len(client.sent) != 0 {
+			t.Errorf("Expected nothing to be passed on, got %v", client.sent)
+		}
+	})
+}
@@ -0,0 +1,23 @@
+package states
+
+import (
+	"server/internal/server"
+	"server/pkg/packets"
+)
+
+// Cuts a full room ranking down to the top of the leaderboard, along with the entry for the player
+// with ID ownId wherever it ranks
+func forwardLeaderboard(client server.ClientInterfacer, senderId uint64, message *packets.Packet_Leaderboard, ownId uint64) {
+	ranking := message.Leaderboard.Entries
+
+	var own *packets.LeaderboardEntryMessage
+	for _, entry := range ranking {
+		if entry.PlayerId == ownId {
+			own = entry
+			break
+		}
+	}
+
+	top := ranking[:min(len(ranking), server.LeaderboardSize)]
+	client.SocketSendAs(packets.NewLeaderboard(top, own), senderId)
+}
@@ -0,0 +1,221 @@
+package states
+
+import (
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+)
+
+// Watching a room without playing in it. The spectator sees what the player it follows sees, but
+// can't move, eat or chat.
+type Spectating struct {
+	client server.ClientInterfacer
+	logger *log.Logger
+
+	// The logged in player to go back to Connected with, if any
+	player *objects.Player
+
+	// Player the spectator asked to follow, or 0 to follow whoever is in the lead
+	followId uint64
+
+	// Player currently being followed, or 0 if the room is empty
+	targetId uint64
+
+	interest *areaOfInterest
+}
+
+func (s *Spectating) Name() string {
+	return "Spectating"
+}
+
+func (s *Spectating) SetClient(client server.ClientInterfacer) {
+	s.client = client
+	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), s.Name())
+	s.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
+	s.interest = newAreaOfInterest(client)
+}
+
+func (s *Spectating) OnEnter() {
+	room := s.client.Room()
+	bounds := room.Bounds()
+	s.client.SocketSend(packets.NewGameBounds(bounds.MinX, bounds.MaxX, bounds.MinY, bounds.MaxY))
+	s.retarget(room.SharedGameObjects)
+}
+
+func (s *Spectating) OnExit() {
+}
+
+func (s *Spectating) HandleMessage(senderId uint64, message packets.Msg) {
+	switch message := message.(type) {
+	case *packets.Packet_WorldUpdate:
+		s.handleWorldUpdate(senderId, message)
+	case *packets.Packet_Player, *packets.Packet_Spore, *packets.Packet_Virus, *packets.Packet_PowerUp:
+		s.handleObject(senderId, message)
+	case *packets.Packet_SporeConsumed:
+		s.interest.forwardSporeConsumed(senderId, message)
+	case *packets.Packet_VirusConsumed:
+		s.interest.forwardVirusConsumed(senderId, message)
+	case *packets.Packet_PowerUpConsumed:
+		s.interest.forwardPowerUpConsumed(senderId, message)
+	case *packets.Packet_PlayerConsumed:
+		s.client.SocketSendAs(message, senderId)
+	case *packets.Packet_RoundCountdown, *packets.Packet_RoundEnd, *packets.Packet_GameBounds, *packets.Packet_TeamScores:
+		s.client.SocketSendAs(message, senderId)
+	case *packets.Packet_Leaderboard:
+		forwardLeaderboard(s.client, senderId, message, s.targetId)
+	case *packets.Packet_RoomOwner:
+		s.client.SocketSendAs(message, senderId)
+	case *packets.Packet_Kicked:
+		s.handleKicked(senderId, message)
+	case *packets.Packet_Chat:
+		s.handleChat(senderId, message)
+	case *packets.Packet_SpectateRequest:
+		s.handleSpectateRequest(senderId, message)
+	case *packets.Packet_LeaveRoomRequest:
+		s.handleLeaveRoomRequest(senderId, message)
+	case *packets.Packet_Disconnect:
+		s.handleDisconnect(senderId, message)
+	}
+}
+
+// Spectators can read the chat but not take part
+func (s *Spectating) handleChat(senderId uint64, message *packets.Packet_Chat) {
+	if senderId != s.client.Id() {
+		s.client.SocketSendAs(message, senderId)
+	}
+}
+
+// The room hands updates to the clients it had when it sent them, so one can arrive after we left
+func (s *Spectating) handleWorldUpdate(senderId uint64, message *packets.Packet_WorldUpdate) {
+	room := s.client.Room()
+	if room == nil {
+		return
+	}
+	s.retarget(room.SharedGameObjects)
+	s.interest.forwardWorldUpdate(senderId, message, room.SharedGameObjects, s.viewport(room), s.targetId)
+}
+
+func (s *Spectating) handleObject(senderId uint64, message packets.Msg) {
+	room := s.client.Room()
+	if room == nil {
+		return
+	}
+	viewport := s.viewport(room)
+	switch message := message.(type) {
+	case *packets.Packet_Player:
+		s.interest.forwardPlayer(senderId, message, viewport)
+	case *packets.Packet_Spore:
+		s.interest.forwardSpore(senderId, message, viewport)
+	case *packets.Packet_Virus:
+		s.interest.forwardVirus(senderId, message, viewport)
+	case *packets.Packet_PowerUp:
+		s.interest.forwardPowerUp(senderId, message, viewport)
+	}
+}
+
+// Switches who to follow within the same room
+func (s *Spectating) handleSpectateRequest(senderId uint64, message *packets.Packet_SpectateRequest) {
+	if senderId != s.client.Id() {
+		return
+	}
+
+	room := s.client.Room()
+	if room == nil {
+		return
+	}
+	if message.SpectateRequest.RoomId != room.Id {
+		s.client.SocketSend(packets.NewDenyResponse("Leave this room before watching another"))
+		return
+	}
+
+	s.followId = message.SpectateRequest.PlayerId
+	s.client.SocketSend(packets.NewOkResponse())
+	s.retarget(room.SharedGameObjects)
+}
+
+func (s *Spectating) handleLeaveRoomRequest(senderId uint64, _ *packets.Packet_LeaveRoomRequest) {
+	if senderId != s.client.Id() {
+		return
+	}
+
+	// SetState in goroutine to avoid blocking Hub
+	go func() {
+		s.client.SetState(&Connected{player: s.player})
+		s.client.LeaveRoom()
+	}()
+}
+
+// The room closing is the only thing that removes spectators
+func (s *Spectating) handleKicked(senderId uint64, message *packets.Packet_Kicked) {
+	if senderId != 0 {
+		return
+	}
+
+	s.client.SocketSend(message)
+	// SetState in goroutine to avoid blocking Hub
+	go func() {
+		s.client.SetState(&Connected{player: s.player})
+		s.client.LeaveRoom()
+	}()
+}
+
+func (s *Spectating) handleDisconnect(senderId uint64, message *packets.Packet_Disconnect) {
+	if senderId == s.client.Id() {
+		// SetState in goroutine to avoid blocking Hub
+		go func() {
+			s.client.SetState(&Connected{})
+			s.client.LeaveRoom()
+		}()
+	} else {
+		s.interest.players.Remove(senderId)
+		go s.client.SocketSendAs(message, senderId)
+	}
+}
+
+// Follows the requested player while they're in the room, and the leader otherwise. Lets the
+// client know whenever that changes.
+func (s *Spectating) retarget(world *server.SharedGameObjects) {
+	players := world.Players
+
+	targetId := uint64(0)
+	if _, ok := players.Get(s.followId); ok && s.followId != 0 {
+		targetId = s.followId
+	} else if leaderId, _, ok := leader(players); ok {
+		targetId = leaderId
+	}
+
+	if targetId != s.targetId {
+		s.targetId = targetId
+		s.client.SocketSend(packets.NewSpectateTarget(targetId))
+	}
+}
+
+// What the followed player can see, or the middle of the room if there is nobody to follow
+func (s *Spectating) viewport(room *server.Room) objects.Viewport {
+	if target, ok := room.SharedGameObjects.Players.Get(s.targetId); ok {
+		return objects.ViewportFor(target)
+	}
+
+	bounds := room.Bounds()
+	return objects.ViewportFor(&objects.Player{
+		X:      (bounds.MinX + bounds.MaxX) / 2,
+		Y:      (bounds.MinY + bounds.MaxY) / 2,
+		Radius: objects.SpawnRadius,
+	})
+}
+
+// The most massive player, breaking ties by lowest ID
+func leader(players *objects.SpatialCollection[*objects.Player]) (uint64, *objects.Player, bool) {
+	var leaderId uint64
+	var leaderPlayer *objects.Player
+	leaderMass := 0.0
+	players.ForEach(func(playerId uint64, player *objects.Player) {
+		mass := player.Mass()
+		if leaderPlayer == nil || mass > leaderMass || (mass == leaderMass && playerId < leaderId) {
+			leaderId, leaderPlayer, leaderMass = playerId, player, mass
+		}
+	})
+	return leaderId, leaderPlayer, leaderPlayer != nil
+}
@@ -0,0 +1,35 @@
+package states
+
+import (
+	"server/internal/server/objects"
+	"testing"
+)
+
+// TestLeader tests picking the player spectators follow by default
+func TestLeader(t *testing.T) {
+	t.Run("Empty room has no leader", func(t *testing.T) {
+		if _, _, ok := leader(objects.NewSpatialCollection[*objects.Player]()); ok {
+			t.Error("Expected no leader in an empty room")
+		}
+	})
+
+	t.Run("Most massive player leads, counting split cells", func(t *testing.T) {
+		players := objects.NewSpatialCollection[*objects.Player]()
+		players.Add(&objects.Player{Radius: 40}, 1)
+		players.Add(&objects.Player{X: 500, Radius: 30, Cells: []*objects.Cell{{X: 560, Radius: 30}}}, 2)
+
+		if leaderId, _, ok := leader(players); !ok || leaderId != 2 {
+			t.Errorf("Expected player 2 to lead, got %d (ok: %v)", leaderId, ok)
+		}
+	})
+
+	t.Run("Ties go to the lowest ID", func(t *testing.T) {
+		players := objects.NewSpatialCollection[*objects.Player]()
+		players.Add(&objects.Player{Radius: 40}, 7)
+		players.Add(&objects.Player{X: 500, Radius: 40}, 3)
+
+		if leaderId, _, ok := leader(players); !ok || leaderId != 3 {
+			t.Errorf("Expected player 3 to lead, got %d (ok: %v)", leaderId, ok)
+		}
+	})
+}
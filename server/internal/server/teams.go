@@ -0,0 +1,57 @@
+package server
+
+import (
+	"cmp"
+	"math"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"slices"
+)
+
+// Each team's combined mass and head count, in team order. Empty teams are included so clients
+// always see the full line-up. Returns nil if the room doesn't play in teams.
+func (r *Room) TeamScores() []*packets.TeamScoreMessage {
+	if r.Teams <= 0 {
+		return nil
+	}
+
+	masses := make([]float64, r.Teams)
+	counts := make([]uint32, r.Teams)
+	r.SharedGameObjects.Players.ForEach(func(_ uint64, player *objects.Player) {
+		if player.Team < 1 || player.Team > r.Teams {
+			return
+		}
+		masses[player.Team-1] += player.Mass()
+		counts[player.Team-1]++
+	})
+
+	scores := make([]*packets.TeamScoreMessage, 0, r.Teams)
+	for i := range r.Teams {
+		scores = append(scores, &packets.TeamScoreMessage{
+			Team:        uint32(i + 1),
+			Score:       uint64(math.Round(masses[i])),
+			PlayerCount: counts[i],
+		})
+	}
+	return scores
+}
+
+// Picks the team a new player should join: the one with the fewest players, or of those, the
+// lightest. Returns 0 if the room doesn't play in teams.
+func (r *Room) AssignTeam() int {
+	scores := r.TeamScores()
+	if len(scores) == 0 {
+		return 0
+	}
+
+	weakest := slices.MinFunc(scores, func(a, b *packets.TeamScoreMessage) int {
+		if c := cmp.Compare(a.PlayerCount, b.PlayerCount); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.Score, b.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Team, b.Team)
+	})
+	return int(weakest.Team)
+}
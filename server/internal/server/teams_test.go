@@ -0,0 +1,76 @@
+package server
+
+import (
+	"math"
+	"server/internal/server/objects"
+	"testing"
+)
+
+func testTeamRoom() *Room {
+	return NewRoom(RoomConfig{Name: "Test", Bounds: objects.DefaultBounds, Teams: 3})
+}
+
+// TestTeams tests splitting a room's players into teams
+func TestTeams(t *testing.T) {
+	t.Run("Free-for-all rooms have no teams", func(t *testing.T) {
+		room := testRoom()
+		if team := room.AssignTeam(); team != 0 {
+			t.Errorf("Expected no team, got %d", team)
+		}
+		if scores := room.TeamScores(); scores != nil {
+			t.Errorf("Expected no team scores, got %v", scores)
+		}
+	})
+
+	t.Run("Scores add up every team's players", func(t *testing.T) {
+		room := testTeamRoom()
+		room.SharedGameObjects.Players.Add(&objects.Player{Radius: 10, Team: 1}, 1)
+		room.SharedGameObjects.Players.Add(&objects.Player{Radius: 20, Team: 1}, 2)
+		room.SharedGameObjects.Players.Add(&objects.Player{Radius: 30, Team: 3}, 3)
+
+		scores := room.TeamScores()
+
+		if len(scores) != 3 {
+			t.Fatalf("Expected a score for each of the 3 teams, got %v", scores)
+		}
+		expectedMass := objects.RadToMass(10) + objects.RadToMass(20)
+		if scores[0].Team != 1 || scores[0].PlayerCount != 2 || math.Abs(float64(scores[0].Score)-expectedMass) > 1 {
+			t.Errorf("Expected team 1 to have 2 players and score %f, got %v", expectedMass, scores[0])
+		}
+		if scores[1].Team != 2 || scores[1].PlayerCount != 0 || scores[1].Score != 0 {
+			t.Errorf("Expected team 2 to be empty, got %v", scores[1])
+		}
+	})
+
+	t.Run("New players join the smallest team", func(t *testing.T) {
+		room := testTeamRoom()
+		room.SharedGameObjects.Players.Add(&objects.Player{Radius: 20, Team: 1}, 1)
+		room.SharedGameObjects.Players.Add(&objects.Player{Radius: 20, Team: 2}, 2)
+
+		if team := room.AssignTeam(); team != 3 {
+			t.Errorf("Expected the empty team 3, got %d", team)
+		}
+	})
+
+	t.Run("Ties in numbers go to the lightest team", func(t *testing.T) {
+		room := testTeamRoom()
+		room.SharedGameObjects.Players.Add(&objects.Player{Radius: 50, Team: 1}, 1)
+		room.SharedGameObjects.Players.Add(&objects.Player{Radius: 20, Team: 2}, 2)
+		room.SharedGameObjects.Players.Add(&objects.Player{Radius: 30, Team: 3}, 3)
+
+		if team := room.AssignTeam(); team != 2 {
+			t.Errorf("Expected the lightest team 2, got %d", team)
+		}
+	})
+
+	t.Run("Teammates share their team's color", func(t *testing.T) {
+		player := &objects.Player{Color: 0x123456ff, Team: 2}
+		if color := player.DisplayColor(); color != int32(objects.TeamColors[1]) {
+			t.Errorf("Expected team 2's color, got %x", color)
+		}
+		player.Team = 0
+		if color := player.DisplayColor(); color != 0x123456ff {
+			t.Errorf("Expected the player's own color without a team, got %x", color)
+		}
+	})
+}
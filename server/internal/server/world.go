@@ -0,0 +1,347 @@
+package server
+
+import (
+	"maps"
+	"math"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"slices"
+	"time"
+)
+
+// How often the world simulation advances
+const TickRate = 50 * time.Millisecond
+
+// A player must be more than this many times as massive as another player to consume it
+const ConsumeMassRatio = 1.5
+
+// A cell which swallows a virus shatters into this many pieces, or loses this fraction of its mass
+// if it can't split any further
+const (
+	VirusPieces int     = 8
+	VirusDamage float64 = 0.3
+)
+
+// Spores ejected by players are this big and start out this fast
+const (
+	EjectedSporeRadius float64 = 12
+	EjectSpeed         float64 = 600
+)
+
+// Advances the whole world at a fixed rate and sends one consolidated update per tick. Rounds are
+// run from here too, so resetting the arena never races with the simulation.
+func (r *Room) worldTickLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	delta := rate.Seconds()
+	for {
+		var now time.Time
+		select {
+		case <-r.done:
+			return
+		case now = <-ticker.C:
+		}
+
+		// The border closes in first so the world step keeps everything inside where it now is
+		events := r.stepBorder(now, delta)
+		events = append(events, r.stepWorld(delta)...)
+		events = append(events, r.stepRound(now)...)
+		for _, packet := range events {
+			select {
+			case r.BroadcastChan <- packet:
+			default:
+				r.logger.Printf("BroadcastChan full, dropping world tick message: %T", packet.Msg)
+			}
+		}
+	}
+}
+
+// Moves every player and sliding spore by one simulation step and resolves any consumption that
+// results. Returns the packets to broadcast, ending with a consolidated update of every player left
+// in the world, or nil if there is nobody in the world.
+func (r *Room) stepWorld(delta float64) []*packets.Packet {
+	now := time.Now()
+	bounds := r.Bounds()
+	players := make(map[uint64]*objects.Player, r.SharedGameObjects.Players.Len())
+	var events []*packets.Packet
+	thinking := r.botsThink(now)
+	r.SharedGameObjects.Players.ForEach(func(playerId uint64, player *objects.Player) {
+		if player.IsBot && thinking {
+			r.steerBot(playerId, player)
+		}
+		events = append(events, r.carryOutIntents(player, now)...)
+
+		// Cells move together, so the main body sets the pace. Splitting off mass speeds it up.
+		if r.SpeedCurve != (objects.SpeedCurve{}) {
+			player.Speed = r.SpeedCurve.SpeedFor(objects.RadToMass(player.Radius))
+		}
+		player.ExpirePowerUps(now)
+		objects.DecayPlayer(player, r.MassDecay, delta)
+		if !player.Frozen() {
+			objects.MovePlayer(player, bounds, delta)
+		}
+		player.SettleCells(now)
+		r.SharedGameObjects.Players.Reindex(playerId)
+		players[playerId] = player
+	})
+
+	for _, player := range players {
+		if player.HasPowerUp(objects.PowerUpMagnet) {
+			r.attractSpores(player)
+		}
+	}
+
+	movingSpores := make(map[uint64]*objects.Spore, r.movingSpores.Len())
+	r.movingSpores.ForEach(func(sporeId uint64, spore *objects.Spore) {
+		if !objects.MoveSpore(spore, bounds, delta) {
+			r.movingSpores.Remove(sporeId)
+		}
+		r.SharedGameObjects.Spores.Reindex(sporeId)
+		movingSpores[sporeId] = spore
+	})
+
+	if len(players) == 0 {
+		return nil
+	}
+
+	// Resolve in ID order so the outcome doesn't depend on map iteration order
+	for _, playerId := range slices.Sorted(maps.Keys(players)) {
+		player, ok := players[playerId]
+		if !ok {
+			// Consumed earlier in this step
+			continue
+		}
+		events = append(events, r.consumeSpores(playerId, player)...)
+		events = append(events, r.consumeViruses(playerId, player, now)...)
+		events = append(events, r.consumePowerUps(playerId, player, now)...)
+		events = append(events, r.consumePlayers(playerId, player, players)...)
+	}
+
+	for _, player := range players {
+		player.PeakMass = max(player.PeakMass, player.Mass())
+	}
+
+	// Spores which were eaten mid-slide are no longer part of the world
+	for sporeId := range movingSpores {
+		if _, exists := r.SharedGameObjects.Spores.Get(sporeId); !exists {
+			delete(movingSpores, sporeId)
+		}
+	}
+
+	return append(events, &packets.Packet{
+		SenderId: 0,
+		Msg:      packets.NewWorldUpdate(players, movingSpores),
+	})
+}
+
+// Does what the player's client asked for since the last step. Returns the packets to broadcast.
+func (r *Room) carryOutIntents(player *objects.Player, now time.Time) []*packets.Packet {
+	var events []*packets.Packet
+	intents := player.TakeIntents()
+	if intents.Has(objects.IntentSteer) {
+		player.Direction = player.Steering()
+	}
+	if intents.Has(objects.IntentSplit) {
+		// The new cells go out with this step's world update
+		player.Split(now)
+	}
+	if intents.Has(objects.IntentEjectMass) {
+		if sporeId, spore, ok := r.EjectMass(player); ok {
+			events = append(events, &packets.Packet{SenderId: 0, Msg: packets.NewSpore(sporeId, spore)})
+		}
+	}
+	return events
+}
+
+// Fires a spore out of the front of the player, taking its mass from the player. Returns false if
+// the player can't spare the mass without shrinking below the spawn size.
+func (r *Room) EjectMass(player *objects.Player) (uint64, *objects.Spore, bool) {
+	sporeMass := objects.RadToMass(EjectedSporeRadius)
+	if objects.RadToMass(player.Radius)-sporeMass < objects.RadToMass(objects.SpawnRadius) {
+		return 0, nil, false
+	}
+	growPlayer(player, -sporeMass)
+
+	// Start the spore just clear of the player's edge
+	dirX, dirY := math.Cos(player.Direction), math.Sin(player.Direction)
+	distance := player.Radius + EjectedSporeRadius
+	spore := &objects.Spore{
+		X:         player.X + dirX*distance,
+		Y:         player.Y + dirY*distance,
+		Radius:    EjectedSporeRadius,
+		DroppedBy: player,
+		DroppedAt: time.Now(),
+		VelocityX: dirX * EjectSpeed,
+		VelocityY: dirY * EjectSpeed,
+	}
+
+	sporeId := r.SharedGameObjects.Spores.Add(spore)
+	r.movingSpores.Add(spore, sporeId)
+	return sporeId, spore, true
+}
+
+// Each of the player's cells eats every spore it overlaps, except ones the player dropped itself
+// too recently
+func (r *Room) consumeSpores(playerId uint64, player *objects.Player) []*packets.Packet {
+	var events []*packets.Packet
+
+	player.ForEachCell(func(cell *objects.Cell) {
+		touching := r.SharedGameObjects.Spores.Within(cell.X, cell.Y, cell.Radius)
+		for _, sporeId := range slices.Sorted(maps.Keys(touching)) {
+			spore := touching[sporeId]
+			if !dropCooldownElapsed(player, spore) {
+				continue
+			}
+
+			r.SharedGameObjects.Spores.Remove(sporeId)
+			r.movingSpores.Remove(sporeId)
+			growCell(cell, objects.RadToMass(spore.Radius))
+			events = append(events, &packets.Packet{
+				SenderId: playerId,
+				Msg:      packets.NewSporeConsumed(sporeId),
+			})
+		}
+	})
+
+	if len(events) > 0 {
+		r.SharedGameObjects.Players.Reindex(playerId)
+	}
+	return events
+}
+
+// Each of the player's cells big enough to swallow a virus whole does so, and shatters for its
+// trouble. Smaller cells pass under viruses unharmed, so they can hide behind them.
+func (r *Room) consumeViruses(playerId uint64, player *objects.Player, now time.Time) []*packets.Packet {
+	var events []*packets.Packet
+
+	player.ForEachCell(func(cell *objects.Cell) {
+		touching := r.SharedGameObjects.Viruses.Within(cell.X, cell.Y, cell.Radius)
+		for _, virusId := range slices.Sorted(maps.Keys(touching)) {
+			virus := touching[virusId]
+			virusMass := objects.RadToMass(virus.Radius)
+			if objects.RadToMass(cell.Radius) <= virusMass*ConsumeMassRatio || math.Hypot(virus.X-cell.X, virus.Y-cell.Y) > cell.Radius {
+				continue
+			}
+
+			r.SharedGameObjects.Viruses.Remove(virusId)
+			growCell(cell, virusMass)
+			if !player.Explode(cell, VirusPieces, now) {
+				growCell(cell, -objects.RadToMass(cell.Radius)*VirusDamage)
+			}
+			events = append(events, &packets.Packet{
+				SenderId: playerId,
+				Msg:      packets.NewVirusConsumed(virusId),
+			})
+			// The cell has been broken up, so it can't swallow any more
+			break
+		}
+	})
+
+	if len(events) > 0 {
+		r.SharedGameObjects.Players.Reindex(playerId)
+	}
+	return events
+}
+
+// Each of the player's cells picks up every power-up it overlaps
+func (r *Room) consumePowerUps(playerId uint64, player *objects.Player, now time.Time) []*packets.Packet {
+	var events []*packets.Packet
+
+	player.ForEachCell(func(cell *objects.Cell) {
+		touching := r.SharedGameObjects.PowerUps.Within(cell.X, cell.Y, cell.Radius)
+		for _, powerUpId := range slices.Sorted(maps.Keys(touching)) {
+			r.SharedGameObjects.PowerUps.Remove(powerUpId)
+			player.GrantPowerUp(touching[powerUpId].Kind, now.Add(objects.PowerUpDuration))
+			events = append(events, &packets.Packet{
+				SenderId: playerId,
+				Msg:      packets.NewPowerUpConsumed(powerUpId),
+			})
+		}
+	})
+
+	return events
+}
+
+// Sets every spore within the player's magnet range sliding towards it. Spores already slide
+// through the world tick, so this only needs to point them in the right direction.
+func (r *Room) attractSpores(player *objects.Player) {
+	for sporeId, spore := range r.SharedGameObjects.Spores.Within(player.X, player.Y, player.Reach()+objects.MagnetRange) {
+		if spore.DroppedBy == player {
+			continue
+		}
+		distance := math.Hypot(player.X-spore.X, player.Y-spore.Y)
+		if distance == 0 {
+			continue
+		}
+		spore.VelocityX = (player.X - spore.X) / distance * objects.MagnetPull
+		spore.VelocityY = (player.Y - spore.Y) / distance * objects.MagnetPull
+		r.movingSpores.Add(spore, sporeId)
+	}
+}
+
+// Each of the player's cells eats every sufficiently smaller cell it overlaps, sparing teammates
+// and shielded players. Players eaten whole are removed from the world and from alive.
+func (r *Room) consumePlayers(playerId uint64, player *objects.Player, alive map[uint64]*objects.Player) []*packets.Packet {
+	var events []*packets.Packet
+	ateSomething := false
+
+	player.ForEachCell(func(cell *objects.Cell) {
+		touching := r.SharedGameObjects.Players.Within(cell.X, cell.Y, cell.Radius)
+		for _, otherId := range slices.Sorted(maps.Keys(touching)) {
+			other := touching[otherId]
+			if _, ok := alive[otherId]; !ok || otherId == playerId || objects.Teammates(player, other) || other.HasPowerUp(objects.PowerUpShield) {
+				continue
+			}
+
+			cellMass := objects.RadToMass(cell.Radius)
+			massEaten, survived := other.RemoveCells(func(otherCell *objects.Cell) bool {
+				return overlaps(cell, otherCell) && cellMass > objects.RadToMass(otherCell.Radius)*ConsumeMassRatio
+			})
+			if massEaten == 0 {
+				continue
+			}
+
+			growCell(cell, massEaten)
+			ateSomething = true
+
+			if survived {
+				r.SharedGameObjects.Players.Reindex(otherId)
+				continue
+			}
+			r.SharedGameObjects.Players.Remove(otherId)
+			delete(alive, otherId)
+			events = append(events, &packets.Packet{
+				SenderId: playerId,
+				Msg:      packets.NewPlayerConsumed(otherId),
+			})
+		}
+	})
+
+	if ateSomething {
+		r.SharedGameObjects.Players.Reindex(playerId)
+	}
+	return events
+}
+
+// Players need time to move clear of spores they dropped before they can eat them again
+func dropCooldownElapsed(player *objects.Player, spore *objects.Spore) bool {
+	if spore.DroppedBy != player || player.Speed <= 0 {
+		return true
+	}
+	clearTime := time.Duration((spore.Radius + player.Radius) / player.Speed * float64(time.Second))
+	return time.Since(spore.DroppedAt) >= clearTime
+}
+
+func growPlayer(player *objects.Player, massDiff float64) {
+	player.Radius = objects.MassToRad(objects.RadToMass(player.Radius) + massDiff)
+}
+
+func growCell(cell *objects.Cell, massDiff float64) {
+	cell.Radius = objects.MassToRad(objects.RadToMass(cell.Radius) + massDiff)
+}
+
+// Whether two cells touch
+func overlaps(a, b *objects.Cell) bool {
+	return math.Hypot(a.X-b.X, a.Y-b.Y) <= a.Radius+b.Radius
+}
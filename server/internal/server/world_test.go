@@ -0,0 +1,541 @@
+package server
+
+import (
+	"math"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"testing"
+	"time"
+)
+
+func testRoom() *Room {
+	return NewRoom(RoomConfig{Name: "Test", Bounds: objects.DefaultBounds})
+}
+
+func lastWorldUpdate(t *testing.T, events []*packets.Packet) *packets.WorldUpdateMessage {
+	t.Helper()
+	if len(events) == 0 {
+		t.Fatal("Expected the world step to produce packets")
+	}
+	update := events[len(events)-1].GetWorldUpdate()
+	if update == nil {
+		t.Fatalf("Expected the last packet to be a world update, got %T", events[len(events)-1].Msg)
+	}
+	return update
+}
+
+// TestStepWorld tests the room's fixed-rate world simulation
+func TestStepWorld(t *testing.T) {
+	t.Run("Empty world produces no update", func(t *testing.T) {
+		room := testRoom()
+
+		if events := room.stepWorld(0.05); len(events) != 0 {
+			t.Errorf("Expected no packets for an empty world, got %v", events)
+		}
+	})
+
+	t.Run("All players advance in a single step", func(t *testing.T) {
+		room := testRoom()
+		right := &objects.Player{Name: "Right", Radius: 20, Speed: 100, Direction: 0}
+		down := &objects.Player{Name: "Down", Radius: 20, Speed: 100, Direction: math.Pi / 2}
+		room.SharedGameObjects.Players.Add(right, 1)
+		room.SharedGameObjects.Players.Add(down, 2)
+
+		room.stepWorld(0.5)
+
+		if math.Abs(right.X-50) > 0.0001 || math.Abs(right.Y) > 0.0001 {
+			t.Errorf("Player moving right ended at (%f, %f), expected (50, 0)", right.X, right.Y)
+		}
+		if math.Abs(down.X) > 0.0001 || math.Abs(down.Y-50) > 0.0001 {
+			t.Errorf("Player moving down ended at (%f, %f), expected (0, 50)", down.X, down.Y)
+		}
+	})
+
+	t.Run("Update contains every player ordered by ID", func(t *testing.T) {
+		room := testRoom()
+		for _, id := range []uint64{7, 3, 5} {
+			room.SharedGameObjects.Players.Add(&objects.Player{Radius: 20, Speed: 100}, id)
+		}
+
+		update := lastWorldUpdate(t, room.stepWorld(0.05))
+
+		players := update.Players
+		if len(players) != 3 {
+			t.Fatalf("Expected 3 players in the update, got %d", len(players))
+		}
+		for i, expectedId := range []uint64{3, 5, 7} {
+			if players[i].Id != expectedId {
+				t.Errorf("Player %d in update has ID %d, expected %d", i, players[i].Id, expectedId)
+			}
+		}
+	})
+
+	t.Run("Players cannot leave the game bounds", func(t *testing.T) {
+		room := testRoom()
+		player := &objects.Player{X: objects.MaxX - 25, Radius: 20, Speed: 150, Direction: 0}
+		room.SharedGameObjects.Players.Add(player, 1)
+
+		for range 100 {
+			room.stepWorld(0.05)
+		}
+
+		if player.X > objects.MaxX-player.Radius {
+			t.Errorf("Player X %f went past the boundary %f", player.X, objects.MaxX-player.Radius)
+		}
+	})
+
+	t.Run("Steering takes effect on the next step", func(t *testing.T) {
+		room := testRoom()
+		player := &objects.Player{Radius: 20, Speed: 100, Direction: 0}
+		room.SharedGameObjects.Players.Add(player, 1)
+
+		player.Steer(math.Pi / 2)
+		if player.Direction != 0 {
+			t.Errorf("Expected the direction to wait for the world step, got %f", player.Direction)
+		}
+
+		room.stepWorld(0.5)
+
+		if math.Abs(player.X) > 0.0001 || math.Abs(player.Y-50) > 0.0001 {
+			t.Errorf("Steered player ended at (%f, %f), expected (0, 50)", player.X, player.Y)
+		}
+	})
+
+	t.Run("Frozen players stay where they are", func(t *testing.T) {
+		room := testRoom()
+		player := &objects.Player{Radius: 20, Speed: 100, Direction: 0}
+		room.SharedGameObjects.Players.Add(player, 1)
+
+		player.SetFrozen(true)
+		room.stepWorld(0.5)
+		if player.X != 0 || player.Y != 0 {
+			t.Errorf("Frozen player moved to (%f, %f)", player.X, player.Y)
+		}
+
+		player.SetFrozen(false)
+		room.stepWorld(0.5)
+		if math.Abs(player.X-50) > 0.0001 {
+			t.Errorf("Unfrozen player ended at X %f, expected 50", player.X)
+		}
+	})
+}
+
+// TestServerSideConsumption tests that the world step detects consumption itself
+func TestServerSideConsumption(t *testing.T) {
+	t.Run("Player eats the spores it overlaps", func(t *testing.T) {
+		room := testRoom()
+		player := &objects.Player{Radius: 20}
+		room.SharedGameObjects.Players.Add(player, 1)
+		nearId := room.SharedGameObjects.Spores.Add(&objects.Spore{X: 25, Radius: 10})
+		farId := room.SharedGameObjects.Spores.Add(&objects.Spore{X: 500, Radius: 10})
+
+		events := room.stepWorld(0.05)
+
+		if _, exists := room.SharedGameObjects.Spores.Get(nearId); exists {
+			t.Error("Overlapping spore should have been consumed")
+		}
+		if _, exists := room.SharedGameObjects.Spores.Get(farId); !exists {
+			t.Error("Distant spore should not have been consumed")
+		}
+
+		expectedRadius := objects.MassToRad(objects.RadToMass(20) + objects.RadToMass(10))
+		if math.Abs(player.Radius-expectedRadius) > 0.0001 {
+			t.Errorf("Player radius %f, expected %f after eating the spore", player.Radius, expectedRadius)
+		}
+
+		consumed := events[0].GetSporeConsumed()
+		if consumed == nil || consumed.SporeId != nearId || events[0].SenderId != 1 {
+			t.Errorf("Expected a spore consumed event for spore %d from player 1, got %v", nearId, events[0])
+		}
+	})
+
+	t.Run("Player cannot immediately eat a spore it dropped", func(t *testing.T) {
+		room := testRoom()
+		player := &objects.Player{Radius: 20, Speed: 150}
+		room.SharedGameObjects.Players.Add(player, 1)
+		sporeId := room.SharedGameObjects.Spores.Add(&objects.Spore{X: 25, Radius: 10, DroppedBy: player, DroppedAt: time.Now()})
+
+		room.stepWorld(0)
+
+		if _, exists := room.SharedGameObjects.Spores.Get(sporeId); !exists {
+			t.Error("Freshly dropped spore should not be eaten by the player who dropped it")
+		}
+	})
+
+	t.Run("Bigger player eats a much smaller one", func(t *testing.T) {
+		room := testRoom()
+		big := &objects.Player{Radius: 40}
+		small := &objects.Player{X: 30, Radius: 20}
+		room.SharedGameObjects.Players.Add(big, 1)
+		room.SharedGameObjects.Players.Add(small, 2)
+
+		events := room.stepWorld(0)
+
+		if _, exists := room.SharedGameObjects.Players.Get(2); exists {
+			t.Error("Smaller player should have been removed from the world")
+		}
+
+		consumed := events[0].GetPlayerConsumed()
+		if consumed == nil || consumed.PlayerId != 2 || events[0].SenderId != 1 {
+			t.Errorf("Expected a player consumed event for player 2 from player 1, got %v", events[0])
+		}
+
+		update := lastWorldUpdate(t, events)
+		if len(update.Players) != 1 || update.Players[0].Id != 1 {
+			t.Errorf("Expected only the surviving player in the world update, got %v", update.Players)
+		}
+	})
+
+	t.Run("Similar sized players cannot eat each other", func(t *testing.T) {
+		room := testRoom()
+		room.SharedGameObjects.Players.Add(&objects.Player{Radius: 22}, 1)
+		room.SharedGameObjects.Players.Add(&objects.Player{X: 30, Radius: 20}, 2)
+
+		events := room.stepWorld(0)
+
+		if len(events) != 1 {
+			t.Errorf("Expected only the world update, got %d packets", len(events))
+		}
+		if room.SharedGameObjects.Players.Len() != 2 {
+			t.Error("Neither player should have been consumed")
+		}
+	})
+
+	t.Run("Teammates cannot eat each other", func(t *testing.T) {
+		room := testRoom()
+		room.SharedGameObjects.Players.Add(&objects.Player{Radius: 40, Team: 1}, 1)
+		room.SharedGameObjects.Players.Add(&objects.Player{X: 30, Radius: 20, Team: 1}, 2)
+
+		room.stepWorld(0)
+
+		if room.SharedGameObjects.Players.Len() != 2 {
+			t.Error("Neither teammate should have been consumed")
+		}
+	})
+}
+
+// TestEjectMass tests players firing spores out of themselves
+func TestEjectMass(t *testing.T) {
+	t.Run("Ejected spore leaves the front of the player", func(t *testing.T) {
+		room := testRoom()
+		player := &objects.Player{Radius: 50, Speed: 150, Direction: 0}
+		room.SharedGameObjects.Players.Add(player, 1)
+
+		sporeId, spore, ok := room.EjectMass(player)
+		if !ok {
+			t.Fatal("Expected a big player to be able to eject mass")
+		}
+		if _, exists := room.SharedGameObjects.Spores.Get(sporeId); !exists {
+			t.Error("Ejected spore should have been added to the room")
+		}
+		if spore.X <= player.X || spore.VelocityX <= 0 || spore.DroppedBy != player {
+			t.Errorf("Expected spore dropped by the player heading right, got %+v", spore)
+		}
+
+		expectedRadius := objects.MassToRad(objects.RadToMass(50) - objects.RadToMass(EjectedSporeRadius))
+		if math.Abs(player.Radius-expectedRadius) > 0.0001 {
+			t.Errorf("Player radius %f, expected %f after ejecting mass", player.Radius, expectedRadius)
+		}
+	})
+
+	t.Run("Queued ejections are carried out by the world step", func(t *testing.T) {
+		room := testRoom()
+		player := &objects.Player{Radius: 50, Speed: 150, Direction: 0}
+		room.SharedGameObjects.Players.Add(player, 1)
+
+		player.Queue(objects.IntentEjectMass)
+		player.Queue(objects.IntentEjectMass)
+		if player.Radius != 50 {
+			t.Fatal("Expected the player to be left alone until the world step")
+		}
+
+		events := room.stepWorld(0.05)
+		if spore := events[0].GetSpore(); spore == nil || events[0].SenderId != 0 {
+			t.Errorf("Expected the new spore to be sent to everyone, got %v", events[0])
+		}
+		if room.SharedGameObjects.Spores.Len() != 1 {
+			t.Errorf("Expected asking twice in one step to eject once, got %d spores", room.SharedGameObjects.Spores.Len())
+		}
+		if player.Radius >= 50 {
+			t.Errorf("Expected the player to shrink, got radius %f", player.Radius)
+		}
+	})
+
+	t.Run("Small players cannot eject mass", func(t *testing.T) {
+		room := testRoom()
+		player := &objects.Player{Radius: objects.SpawnRadius, Speed: 150}
+		room.SharedGameObjects.Players.Add(player, 1)
+
+		if _, _, ok := room.EjectMass(player); ok {
+			t.Error("Player at spawn size should not be able to eject mass")
+		}
+		if player.Radius != objects.SpawnRadius {
+			t.Errorf("Player radius changed to %f despite the ejection failing", player.Radius)
+		}
+	})
+
+	t.Run("Ejected spore slides and comes to rest", func(t *testing.T) {
+		room := testRoom()
+		player := &objects.Player{Radius: 50, Speed: 150, Direction: math.Pi / 2}
+		room.SharedGameObjects.Players.Add(player, 1)
+		sporeId, spore, _ := room.EjectMass(player)
+		startY := spore.Y
+
+		update := lastWorldUpdate(t, room.stepWorld(0.05))
+		if len(update.Spores) != 1 || update.Spores[0].Id != sporeId {
+			t.Errorf("Expected the sliding spore in the world update, got %v", update.Spores)
+		}
+		if spore.Y <= startY {
+			t.Errorf("Spore Y %f should have moved past %f", spore.Y, startY)
+		}
+
+		// Head away so the player doesn't catch up and eat the spore
+		player.Direction = -math.Pi / 2
+		for range 100 {
+			room.stepWorld(0.05)
+		}
+
+		if _, exists := room.SharedGameObjects.Spores.Get(sporeId); !exists {
+			t.Fatal("Spore should still be in the room")
+		}
+
+		if spore.VelocityX != 0 || spore.VelocityY != 0 {
+			t.Errorf("Spore should have come to rest, still moving at (%f, %f)", spore.VelocityX, spore.VelocityY)
+		}
+		if update := lastWorldUpdate(t, room.stepWorld(0.05)); len(update.Spores) != 0 {
+			t.Errorf("Resting spores should not be in the world update, got %v", update.Spores)
+		}
+	})
+}
+
+// TestCellConsumption tests that consumption is resolved per cell
+func TestCellConsumption(t *testing.T) {
+	t.Run("Split cells eat spores for themselves", func(t *testing.T) {
+		room := testRoom()
+		player := &objects.Player{Radius: 30, Cells: []*objects.Cell{{X: 300, Radius: 30}}}
+		room.SharedGameObjects.Players.Add(player, 1)
+		sporeId := room.SharedGameObjects.Spores.Add(&objects.Spore{X: 330, Radius: 10})
+
+		room.stepWorld(0)
+
+		if _, exists := room.SharedGameObjects.Spores.Get(sporeId); exists {
+			t.Error("Spore overlapping the split cell should have been consumed")
+		}
+		if player.Radius != 30 || player.Cells[0].Radius <= 30 {
+			t.Errorf("Only the split cell should have grown, got main %f and cell %f", player.Radius, player.Cells[0].Radius)
+		}
+	})
+
+	t.Run("Queued splits are carried out by the world step", func(t *testing.T) {
+		room := testRoom()
+		player := &objects.Player{Radius: 60, Speed: 100}
+		room.SharedGameObjects.Players.Add(player, 1)
+
+		player.Queue(objects.IntentSplit)
+		if len(player.Cells) != 0 {
+			t.Fatal("Expected the player to be left alone until the world step")
+		}
+
+		update := lastWorldUpdate(t, room.stepWorld(0.05))
+		if len(player.Cells) != 1 || len(update.Players[0].Cells) != 1 {
+			t.Errorf("Expected the split cell in the player and this step's update, got %d and %v", len(player.Cells), update.Players[0].Cells)
+		}
+	})
+
+	t.Run("Player survives losing one of its cells", func(t *testing.T) {
+		room := testRoom()
+		big := &objects.Player{Radius: 40}
+		split := &objects.Player{X: 500, Radius: 30, Cells: []*objects.Cell{{X: 30, Radius: 15}}}
+		room.SharedGameObjects.Players.Add(big, 1)
+		room.SharedGameObjects.Players.Add(split, 2)
+
+		events := room.stepWorld(0)
+
+		if len(split.Cells) != 0 || split.Radius != 30 {
+			t.Errorf("Expected only the small split cell to be eaten, got %+v", split)
+		}
+		if len(events) != 1 {
+			t.Errorf("Expected only the world update, got %d packets", len(events))
+		}
+		if update := lastWorldUpdate(t, events); len(update.Players) != 2 {
+			t.Errorf("Expected both players in the world update, got %v", update.Players)
+		}
+	})
+}
+
+// TestMassDependentSpeed tests that the world step applies the room's speed curve
+func TestMassDependentSpeed(t *testing.T) {
+	room := NewRoom(RoomConfig{Name: "Test", Bounds: objects.DefaultBounds, SpeedCurve: objects.DefaultSpeedCurve})
+	small := &objects.Player{Radius: objects.SpawnRadius}
+	big := &objects.Player{X: 1000, Radius: objects.SpawnRadius * 5}
+	room.SharedGameObjects.Players.Add(small, 1)
+	room.SharedGameObjects.Players.Add(big, 2)
+
+	update := lastWorldUpdate(t, room.stepWorld(0.05))
+
+	if small.Speed != objects.DefaultSpeedCurve.BaseSpeed {
+		t.Errorf("Expected spawn-sized player to move at %f, got %f", objects.DefaultSpeedCurve.BaseSpeed, small.Speed)
+	}
+	if big.Speed >= small.Speed {
+		t.Errorf("Expected the big player to be slower, got %f vs %f", big.Speed, small.Speed)
+	}
+	if update.Players[1].Speed != big.Speed {
+		t.Errorf("Expected the world update to report speed %f, got %f", big.Speed, update.Players[1].Speed)
+	}
+}
+
+// TestMassDecay tests that the world step shrinks big players while remembering their peak
+func TestMassDecay(t *testing.T) {
+	room := NewRoom(RoomConfig{Name: "Test", Bounds: objects.DefaultBounds, MassDecay: objects.MassDecay{Threshold: 1000, Rate: 0.5}})
+	player := &objects.Player{Radius: 100}
+	room.SharedGameObjects.Players.Add(player, 1)
+	startMass := player.Mass()
+
+	room.stepWorld(0.05)
+	room.stepWorld(0.05)
+
+	if player.Mass() >= startMass {
+		t.Errorf("Expected the player to shrink from mass %f, got %f", startMass, player.Mass())
+	}
+	if math.Abs(player.PeakMass-startMass) > startMass*0.05 {
+		t.Errorf("Expected peak mass close to the starting mass %f, got %f", startMass, player.PeakMass)
+	}
+}
+
+// TestViruses tests cells running into viruses
+func TestViruses(t *testing.T) {
+	t.Run("Big cell swallowing a virus shatters", func(t *testing.T) {
+		room := testRoom()
+		player := &objects.Player{Radius: 100}
+		room.SharedGameObjects.Players.Add(player, 1)
+		virusId := room.SharedGameObjects.Viruses.Add(&objects.Virus{X: 10, Radius: objects.VirusRadius})
+		expectedMass := player.Mass() + objects.RadToMass(objects.VirusRadius)
+
+		events := room.stepWorld(0)
+
+		if _, exists := room.SharedGameObjects.Viruses.Get(virusId); exists {
+			t.Error("Virus should have been consumed")
+		}
+		if cells := 1 + len(player.Cells); cells != VirusPieces {
+			t.Errorf("Expected the player to shatter into %d cells, got %d", VirusPieces, cells)
+		}
+		if math.Abs(player.Mass()-expectedMass) > 0.0001 {
+			t.Errorf("Expected total mass %f, got %f", expectedMass, player.Mass())
+		}
+
+		consumed := events[0].GetVirusConsumed()
+		if consumed == nil || consumed.VirusId != virusId || events[0].SenderId != 1 {
+			t.Errorf("Expected a virus consumed event for virus %d from player 1, got %v", virusId, events[0])
+		}
+	})
+
+	t.Run("Small cells can hide behind viruses", func(t *testing.T) {
+		room := testRoom()
+		player := &objects.Player{X: 40, Radius: objects.SpawnRadius}
+		room.SharedGameObjects.Players.Add(player, 1)
+		virusId := room.SharedGameObjects.Viruses.Add(&objects.Virus{Radius: objects.VirusRadius})
+
+		room.stepWorld(0)
+
+		if _, exists := room.SharedGameObjects.Viruses.Get(virusId); !exists {
+			t.Error("Small player should not have consumed the virus")
+		}
+		if player.Radius != objects.SpawnRadius || len(player.Cells) != 0 {
+			t.Errorf("Small player should be unaffected by the virus, got %+v", player)
+		}
+	})
+
+	t.Run("Cells that can't split any further are damaged instead", func(t *testing.T) {
+		room := testRoom()
+		player := &objects.Player{Radius: 100}
+		for i := range objects.MaxCells - 1 {
+			player.Cells = append(player.Cells, &objects.Cell{X: 1000 + float64(i)*100, Radius: 20})
+		}
+		room.SharedGameObjects.Players.Add(player, 1)
+		room.SharedGameObjects.Viruses.Add(&objects.Virus{Radius: objects.VirusRadius})
+
+		room.stepWorld(0)
+
+		expectedRadius := objects.MassToRad((objects.RadToMass(100) + objects.RadToMass(objects.VirusRadius)) * (1 - VirusDamage))
+		if math.Abs(player.Radius-expectedRadius) > 0.0001 {
+			t.Errorf("Expected the main body to shrink to radius %f, got %f", expectedRadius, player.Radius)
+		}
+	})
+}
+
+// TestPowerUps tests picking up power-ups and their effects in the world simulation
+func TestPowerUps(t *testing.T) {
+	t.Run("Touching a power-up grants it", func(t *testing.T) {
+		room := testRoom()
+		player := &objects.Player{Radius: 30}
+		room.SharedGameObjects.Players.Add(player, 1)
+		powerUpId := room.SharedGameObjects.PowerUps.Add(&objects.PowerUp{X: 20, Radius: objects.PowerUpRadius, Kind: objects.PowerUpMagnet})
+
+		events := room.stepWorld(0)
+
+		if _, exists := room.SharedGameObjects.PowerUps.Get(powerUpId); exists {
+			t.Error("Expected the power-up to have been picked up")
+		}
+		if !player.HasPowerUp(objects.PowerUpMagnet) {
+			t.Error("Expected the player to have the magnet")
+		}
+		consumed := events[0].GetPowerUpConsumed()
+		if consumed == nil || consumed.PowerUpId != powerUpId || events[0].SenderId != 1 {
+			t.Errorf("Expected a power-up consumed event from player 1, got %v", events[0])
+		}
+		active := lastWorldUpdate(t, events).Players[0].PowerUps
+		if len(active) != 1 || active[0].Kind != uint32(objects.PowerUpMagnet) || active[0].SecondsLeft <= 0 {
+			t.Errorf("Expected the world update to show the active magnet, got %v", active)
+		}
+	})
+
+	t.Run("Shielded players cannot be eaten", func(t *testing.T) {
+		room := testRoom()
+		small := &objects.Player{X: 30, Radius: 20}
+		small.GrantPowerUp(objects.PowerUpShield, time.Now().Add(objects.PowerUpDuration))
+		room.SharedGameObjects.Players.Add(&objects.Player{Radius: 40}, 1)
+		room.SharedGameObjects.Players.Add(small, 2)
+
+		room.stepWorld(0)
+
+		if _, exists := room.SharedGameObjects.Players.Get(2); !exists {
+			t.Error("Shielded player should not have been consumed")
+		}
+	})
+
+	t.Run("Expired power-ups are taken away", func(t *testing.T) {
+		room := testRoom()
+		player := &objects.Player{Radius: 20}
+		player.GrantPowerUp(objects.PowerUpShield, time.Now().Add(-time.Millisecond))
+		room.SharedGameObjects.Players.Add(player, 1)
+
+		room.stepWorld(0)
+
+		if player.HasPowerUp(objects.PowerUpShield) {
+			t.Error("Expected the shield to have worn off")
+		}
+	})
+
+	t.Run("Magnet pulls nearby spores in", func(t *testing.T) {
+		room := testRoom()
+		player := &objects.Player{Radius: 20}
+		player.GrantPowerUp(objects.PowerUpMagnet, time.Now().Add(objects.PowerUpDuration))
+		room.SharedGameObjects.Players.Add(player, 1)
+		nearId := room.SharedGameObjects.Spores.Add(&objects.Spore{X: 200, Radius: 10})
+		farId := room.SharedGameObjects.Spores.Add(&objects.Spore{X: 1000, Radius: 10})
+
+		events := room.stepWorld(0.1)
+
+		near, _ := room.SharedGameObjects.Spores.Get(nearId)
+		if near.X >= 200 {
+			t.Errorf("Expected the nearby spore to be pulled in, got x = %f", near.X)
+		}
+		far, _ := room.SharedGameObjects.Spores.Get(farId)
+		if far.X != 1000 {
+			t.Errorf("Expected the distant spore to stay put, got x = %f", far.X)
+		}
+		if spores := lastWorldUpdate(t, events).Spores; len(spores) != 1 || spores[0].Id != nearId {
+			t.Errorf("Expected the pulled spore in the world update, got %v", spores)
+		}
+	})
+}
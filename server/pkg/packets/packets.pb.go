@@ -302,15 +302,18 @@ func (x *DenyResponseMessage) GetReason() string {
 }
 
 type PlayerMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	X             float64                `protobuf:"fixed64,3,opt,name=x,proto3" json:"x,omitempty"`
-	Y             float64                `protobuf:"fixed64,4,opt,name=y,proto3" json:"y,omitempty"`
-	Radius        float64                `protobuf:"fixed64,5,opt,name=radius,proto3" json:"radius,omitempty"`
-	Direction     float64                `protobuf:"fixed64,6,opt,name=direction,proto3" json:"direction,omitempty"`
-	Speed         float64                `protobuf:"fixed64,7,opt,name=speed,proto3" json:"speed,omitempty"`
-	Color         int32                  `protobuf:"varint,8,opt,name=color,proto3" json:"color,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            uint64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	X             float64                 `protobuf:"fixed64,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                 `protobuf:"fixed64,4,opt,name=y,proto3" json:"y,omitempty"`
+	Radius        float64                 `protobuf:"fixed64,5,opt,name=radius,proto3" json:"radius,omitempty"`
+	Direction     float64                 `protobuf:"fixed64,6,opt,name=direction,proto3" json:"direction,omitempty"`
+	Speed         float64                 `protobuf:"fixed64,7,opt,name=speed,proto3" json:"speed,omitempty"`
+	Color         int32                   `protobuf:"varint,8,opt,name=color,proto3" json:"color,omitempty"`
+	Cells         []*CellMessage          `protobuf:"bytes,9,rep,name=cells,proto3" json:"cells,omitempty"`
+	Team          uint32                  `protobuf:"varint,10,opt,name=team,proto3" json:"team,omitempty"`
+	PowerUps      []*ActivePowerUpMessage `protobuf:"bytes,11,rep,name=power_ups,json=powerUps,proto3" json:"power_ups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -401,6 +404,140 @@ func (x *PlayerMessage) GetColor() int32 {
 	return 0
 }
 
+func (x *PlayerMessage) GetCells() []*CellMessage {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *PlayerMessage) GetTeam() uint32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+func (x *PlayerMessage) GetPowerUps() []*ActivePowerUpMessage {
+	if x != nil {
+		return x.PowerUps
+	}
+	return nil
+}
+
+type CellMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             float64                `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+	Radius        float64                `protobuf:"fixed64,3,opt,name=radius,proto3" json:"radius,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CellMessage) Reset() {
+	*x = CellMessage{}
+	mi := &file_packets_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CellMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CellMessage) ProtoMessage() {}
+
+func (x *CellMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CellMessage.ProtoReflect.Descriptor instead.
+func (*CellMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CellMessage) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *CellMessage) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *CellMessage) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+// Kinds are 1 for speed, 2 for shield and 3 for magnet
+type ActivePowerUpMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          uint32                 `protobuf:"varint,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	SecondsLeft   float64                `protobuf:"fixed64,2,opt,name=seconds_left,json=secondsLeft,proto3" json:"seconds_left,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivePowerUpMessage) Reset() {
+	*x = ActivePowerUpMessage{}
+	mi := &file_packets_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivePowerUpMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivePowerUpMessage) ProtoMessage() {}
+
+func (x *ActivePowerUpMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivePowerUpMessage.ProtoReflect.Descriptor instead.
+func (*ActivePowerUpMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ActivePowerUpMessage) GetKind() uint32 {
+	if x != nil {
+		return x.Kind
+	}
+	return 0
+}
+
+func (x *ActivePowerUpMessage) GetSecondsLeft() float64 {
+	if x != nil {
+		return x.SecondsLeft
+	}
+	return 0
+}
+
 type PlayerDirectionMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Direction     float64                `protobuf:"fixed64,1,opt,name=direction,proto3" json:"direction,omitempty"`
@@ -410,7 +547,7 @@ type PlayerDirectionMessage struct {
 
 func (x *PlayerDirectionMessage) Reset() {
 	*x = PlayerDirectionMessage{}
-	mi := &file_packets_proto_msgTypes[7]
+	mi := &file_packets_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -422,7 +559,7 @@ func (x *PlayerDirectionMessage) String() string {
 func (*PlayerDirectionMessage) ProtoMessage() {}
 
 func (x *PlayerDirectionMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[7]
+	mi := &file_packets_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -435,7 +572,7 @@ func (x *PlayerDirectionMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerDirectionMessage.ProtoReflect.Descriptor instead.
 func (*PlayerDirectionMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{7}
+	return file_packets_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PlayerDirectionMessage) GetDirection() float64 {
@@ -457,7 +594,7 @@ type SporeMessage struct {
 
 func (x *SporeMessage) Reset() {
 	*x = SporeMessage{}
-	mi := &file_packets_proto_msgTypes[8]
+	mi := &file_packets_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -469,7 +606,7 @@ func (x *SporeMessage) String() string {
 func (*SporeMessage) ProtoMessage() {}
 
 func (x *SporeMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[8]
+	mi := &file_packets_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -482,7 +619,7 @@ func (x *SporeMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SporeMessage.ProtoReflect.Descriptor instead.
 func (*SporeMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{8}
+	return file_packets_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SporeMessage) GetId() uint64 {
@@ -522,7 +659,7 @@ type SporeConsumedMessage struct {
 
 func (x *SporeConsumedMessage) Reset() {
 	*x = SporeConsumedMessage{}
-	mi := &file_packets_proto_msgTypes[9]
+	mi := &file_packets_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -534,7 +671,7 @@ func (x *SporeConsumedMessage) String() string {
 func (*SporeConsumedMessage) ProtoMessage() {}
 
 func (x *SporeConsumedMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[9]
+	mi := &file_packets_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,7 +684,7 @@ func (x *SporeConsumedMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SporeConsumedMessage.ProtoReflect.Descriptor instead.
 func (*SporeConsumedMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{9}
+	return file_packets_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SporeConsumedMessage) GetSporeId() uint64 {
@@ -566,7 +703,7 @@ type SporesBatchMessage struct {
 
 func (x *SporesBatchMessage) Reset() {
 	*x = SporesBatchMessage{}
-	mi := &file_packets_proto_msgTypes[10]
+	mi := &file_packets_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -578,7 +715,7 @@ func (x *SporesBatchMessage) String() string {
 func (*SporesBatchMessage) ProtoMessage() {}
 
 func (x *SporesBatchMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[10]
+	mi := &file_packets_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -591,7 +728,7 @@ func (x *SporesBatchMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SporesBatchMessage.ProtoReflect.Descriptor instead.
 func (*SporesBatchMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{10}
+	return file_packets_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SporesBatchMessage) GetSpores() []*SporeMessage {
@@ -610,7 +747,7 @@ type PlayerConsumedMessage struct {
 
 func (x *PlayerConsumedMessage) Reset() {
 	*x = PlayerConsumedMessage{}
-	mi := &file_packets_proto_msgTypes[11]
+	mi := &file_packets_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -622,7 +759,7 @@ func (x *PlayerConsumedMessage) String() string {
 func (*PlayerConsumedMessage) ProtoMessage() {}
 
 func (x *PlayerConsumedMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[11]
+	mi := &file_packets_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -635,7 +772,7 @@ func (x *PlayerConsumedMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerConsumedMessage.ProtoReflect.Descriptor instead.
 func (*PlayerConsumedMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{11}
+	return file_packets_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PlayerConsumedMessage) GetPlayerId() uint64 {
@@ -653,7 +790,7 @@ type HiscoreBoardRequestMessage struct {
 
 func (x *HiscoreBoardRequestMessage) Reset() {
 	*x = HiscoreBoardRequestMessage{}
-	mi := &file_packets_proto_msgTypes[12]
+	mi := &file_packets_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -665,7 +802,7 @@ func (x *HiscoreBoardRequestMessage) String() string {
 func (*HiscoreBoardRequestMessage) ProtoMessage() {}
 
 func (x *HiscoreBoardRequestMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[12]
+	mi := &file_packets_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,7 +815,7 @@ func (x *HiscoreBoardRequestMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HiscoreBoardRequestMessage.ProtoReflect.Descriptor instead.
 func (*HiscoreBoardRequestMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{12}
+	return file_packets_proto_rawDescGZIP(), []int{14}
 }
 
 type HiscoreMessage struct {
@@ -692,7 +829,7 @@ type HiscoreMessage struct {
 
 func (x *HiscoreMessage) Reset() {
 	*x = HiscoreMessage{}
-	mi := &file_packets_proto_msgTypes[13]
+	mi := &file_packets_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -704,7 +841,7 @@ func (x *HiscoreMessage) String() string {
 func (*HiscoreMessage) ProtoMessage() {}
 
 func (x *HiscoreMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[13]
+	mi := &file_packets_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -717,7 +854,7 @@ func (x *HiscoreMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HiscoreMessage.ProtoReflect.Descriptor instead.
 func (*HiscoreMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{13}
+	return file_packets_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *HiscoreMessage) GetRank() uint64 {
@@ -750,7 +887,7 @@ type HiscoreBoardMessage struct {
 
 func (x *HiscoreBoardMessage) Reset() {
 	*x = HiscoreBoardMessage{}
-	mi := &file_packets_proto_msgTypes[14]
+	mi := &file_packets_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -762,7 +899,7 @@ func (x *HiscoreBoardMessage) String() string {
 func (*HiscoreBoardMessage) ProtoMessage() {}
 
 func (x *HiscoreBoardMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[14]
+	mi := &file_packets_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,7 +912,7 @@ func (x *HiscoreBoardMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HiscoreBoardMessage.ProtoReflect.Descriptor instead.
 func (*HiscoreBoardMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{14}
+	return file_packets_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *HiscoreBoardMessage) GetHiscores() []*HiscoreMessage {
@@ -793,7 +930,7 @@ type FinishedBrowsingHiscoresMessage struct {
 
 func (x *FinishedBrowsingHiscoresMessage) Reset() {
 	*x = FinishedBrowsingHiscoresMessage{}
-	mi := &file_packets_proto_msgTypes[15]
+	mi := &file_packets_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -805,7 +942,7 @@ func (x *FinishedBrowsingHiscoresMessage) String() string {
 func (*FinishedBrowsingHiscoresMessage) ProtoMessage() {}
 
 func (x *FinishedBrowsingHiscoresMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[15]
+	mi := &file_packets_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -818,7 +955,7 @@ func (x *FinishedBrowsingHiscoresMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinishedBrowsingHiscoresMessage.ProtoReflect.Descriptor instead.
 func (*FinishedBrowsingHiscoresMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{15}
+	return file_packets_proto_rawDescGZIP(), []int{17}
 }
 
 type SearchHiscoreMessage struct {
@@ -830,7 +967,7 @@ type SearchHiscoreMessage struct {
 
 func (x *SearchHiscoreMessage) Reset() {
 	*x = SearchHiscoreMessage{}
-	mi := &file_packets_proto_msgTypes[16]
+	mi := &file_packets_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -842,7 +979,7 @@ func (x *SearchHiscoreMessage) String() string {
 func (*SearchHiscoreMessage) ProtoMessage() {}
 
 func (x *SearchHiscoreMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[16]
+	mi := &file_packets_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -855,7 +992,7 @@ func (x *SearchHiscoreMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchHiscoreMessage.ProtoReflect.Descriptor instead.
 func (*SearchHiscoreMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{16}
+	return file_packets_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SearchHiscoreMessage) GetName() string {
@@ -874,7 +1011,7 @@ type DisconnectMessage struct {
 
 func (x *DisconnectMessage) Reset() {
 	*x = DisconnectMessage{}
-	mi := &file_packets_proto_msgTypes[17]
+	mi := &file_packets_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -886,7 +1023,7 @@ func (x *DisconnectMessage) String() string {
 func (*DisconnectMessage) ProtoMessage() {}
 
 func (x *DisconnectMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[17]
+	mi := &file_packets_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,7 +1036,7 @@ func (x *DisconnectMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisconnectMessage.ProtoReflect.Descriptor instead.
 func (*DisconnectMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{17}
+	return file_packets_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DisconnectMessage) GetReason() string {
@@ -921,7 +1058,7 @@ type GameBoundsMessage struct {
 
 func (x *GameBoundsMessage) Reset() {
 	*x = GameBoundsMessage{}
-	mi := &file_packets_proto_msgTypes[18]
+	mi := &file_packets_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -933,7 +1070,7 @@ func (x *GameBoundsMessage) String() string {
 func (*GameBoundsMessage) ProtoMessage() {}
 
 func (x *GameBoundsMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[18]
+	mi := &file_packets_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -946,7 +1083,7 @@ func (x *GameBoundsMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameBoundsMessage.ProtoReflect.Descriptor instead.
 func (*GameBoundsMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{18}
+	return file_packets_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GameBoundsMessage) GetMinX() float64 {
@@ -977,50 +1114,29 @@ func (x *GameBoundsMessage) GetMaxY() float64 {
 	return 0
 }
 
-type Packet struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	SenderId uint64                 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	// Types that are valid to be assigned to Msg:
-	//
-	//	*Packet_Chat
-	//	*Packet_Id
-	//	*Packet_LoginRequest
-	//	*Packet_RegisterRequest
-	//	*Packet_OkResponse
-	//	*Packet_DenyResponse
-	//	*Packet_Player
-	//	*Packet_PlayerDirection
-	//	*Packet_Spore
-	//	*Packet_SporeConsumed
-	//	*Packet_SporesBatch
-	//	*Packet_PlayerConsumed
-	//	*Packet_HiScoreBoardRequest
-	//	*Packet_Hiscore
-	//	*Packet_HiscoreBoard
-	//	*Packet_FinishedBrowsingHiscores
-	//	*Packet_SearchHiscore
-	//	*Packet_Disconnect
-	//	*Packet_GameBounds
-	Msg           isPacket_Msg `protobuf_oneof:"msg"`
+type WorldUpdateMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Players       []*PlayerMessage       `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	Spores        []*SporeMessage        `protobuf:"bytes,2,rep,name=spores,proto3" json:"spores,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Packet) Reset() {
-	*x = Packet{}
-	mi := &file_packets_proto_msgTypes[19]
+func (x *WorldUpdateMessage) Reset() {
+	*x = WorldUpdateMessage{}
+	mi := &file_packets_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Packet) String() string {
+func (x *WorldUpdateMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Packet) ProtoMessage() {}
+func (*WorldUpdateMessage) ProtoMessage() {}
 
-func (x *Packet) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[19]
+func (x *WorldUpdateMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1031,72 +1147,2072 @@ func (x *Packet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Packet.ProtoReflect.Descriptor instead.
-func (*Packet) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use WorldUpdateMessage.ProtoReflect.Descriptor instead.
+func (*WorldUpdateMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Packet) GetSenderId() uint64 {
+func (x *WorldUpdateMessage) GetPlayers() []*PlayerMessage {
 	if x != nil {
-		return x.SenderId
+		return x.Players
 	}
-	return 0
+	return nil
 }
 
-func (x *Packet) GetMsg() isPacket_Msg {
+func (x *WorldUpdateMessage) GetSpores() []*SporeMessage {
 	if x != nil {
-		return x.Msg
+		return x.Spores
 	}
 	return nil
 }
 
-func (x *Packet) GetChat() *ChatMessage {
+type OutOfViewMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerIds     []uint64               `protobuf:"varint,1,rep,packed,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"`
+	SporeIds      []uint64               `protobuf:"varint,2,rep,packed,name=spore_ids,json=sporeIds,proto3" json:"spore_ids,omitempty"`
+	VirusIds      []uint64               `protobuf:"varint,3,rep,packed,name=virus_ids,json=virusIds,proto3" json:"virus_ids,omitempty"`
+	PowerUpIds    []uint64               `protobuf:"varint,4,rep,packed,name=power_up_ids,json=powerUpIds,proto3" json:"power_up_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutOfViewMessage) Reset() {
+	*x = OutOfViewMessage{}
+	mi := &file_packets_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutOfViewMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutOfViewMessage) ProtoMessage() {}
+
+func (x *OutOfViewMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[22]
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Chat); ok {
-			return x.Chat
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Packet) GetId() *IdMessage {
+// Deprecated: Use OutOfViewMessage.ProtoReflect.Descriptor instead.
+func (*OutOfViewMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *OutOfViewMessage) GetPlayerIds() []uint64 {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Id); ok {
-			return x.Id
-		}
+		return x.PlayerIds
 	}
 	return nil
 }
 
-func (x *Packet) GetLoginRequest() *LoginRequestMessage {
+func (x *OutOfViewMessage) GetSporeIds() []uint64 {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_LoginRequest); ok {
-			return x.LoginRequest
-		}
+		return x.SporeIds
 	}
 	return nil
 }
 
-func (x *Packet) GetRegisterRequest() *RegisterRequestMessage {
+func (x *OutOfViewMessage) GetVirusIds() []uint64 {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_RegisterRequest); ok {
-			return x.RegisterRequest
-		}
+		return x.VirusIds
 	}
 	return nil
 }
 
-func (x *Packet) GetOkResponse() *OkResponseMessage {
+func (x *OutOfViewMessage) GetPowerUpIds() []uint64 {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_OkResponse); ok {
-			return x.OkResponse
-		}
+		return x.PowerUpIds
 	}
 	return nil
 }
 
-func (x *Packet) GetDenyResponse() *DenyResponseMessage {
-	if x != nil {
+type RoomMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PlayerCount   uint32                 `protobuf:"varint,3,opt,name=player_count,json=playerCount,proto3" json:"player_count,omitempty"`
+	MaxPlayers    uint32                 `protobuf:"varint,4,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomMessage) Reset() {
+	*x = RoomMessage{}
+	mi := &file_packets_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomMessage) ProtoMessage() {}
+
+func (x *RoomMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomMessage.ProtoReflect.Descriptor instead.
+func (*RoomMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RoomMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RoomMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoomMessage) GetPlayerCount() uint32 {
+	if x != nil {
+		return x.PlayerCount
+	}
+	return 0
+}
+
+func (x *RoomMessage) GetMaxPlayers() uint32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+type RoomListRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomListRequestMessage) Reset() {
+	*x = RoomListRequestMessage{}
+	mi := &file_packets_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomListRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomListRequestMessage) ProtoMessage() {}
+
+func (x *RoomListRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomListRequestMessage.ProtoReflect.Descriptor instead.
+func (*RoomListRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{24}
+}
+
+type RoomListMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rooms         []*RoomMessage         `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomListMessage) Reset() {
+	*x = RoomListMessage{}
+	mi := &file_packets_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomListMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomListMessage) ProtoMessage() {}
+
+func (x *RoomListMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomListMessage.ProtoReflect.Descriptor instead.
+func (*RoomListMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RoomListMessage) GetRooms() []*RoomMessage {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+type JoinRoomRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        uint64                 `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRoomRequestMessage) Reset() {
+	*x = JoinRoomRequestMessage{}
+	mi := &file_packets_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRoomRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRoomRequestMessage) ProtoMessage() {}
+
+func (x *JoinRoomRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRoomRequestMessage.ProtoReflect.Descriptor instead.
+func (*JoinRoomRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *JoinRoomRequestMessage) GetRoomId() uint64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+type LeaveRoomRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveRoomRequestMessage) Reset() {
+	*x = LeaveRoomRequestMessage{}
+	mi := &file_packets_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveRoomRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveRoomRequestMessage) ProtoMessage() {}
+
+func (x *LeaveRoomRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveRoomRequestMessage.ProtoReflect.Descriptor instead.
+func (*LeaveRoomRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{27}
+}
+
+type EjectMassMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EjectMassMessage) Reset() {
+	*x = EjectMassMessage{}
+	mi := &file_packets_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EjectMassMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EjectMassMessage) ProtoMessage() {}
+
+func (x *EjectMassMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EjectMassMessage.ProtoReflect.Descriptor instead.
+func (*EjectMassMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{28}
+}
+
+type SplitMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplitMessage) Reset() {
+	*x = SplitMessage{}
+	mi := &file_packets_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitMessage) ProtoMessage() {}
+
+func (x *SplitMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitMessage.ProtoReflect.Descriptor instead.
+func (*SplitMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{29}
+}
+
+type VirusMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	X             float64                `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
+	Radius        float64                `protobuf:"fixed64,4,opt,name=radius,proto3" json:"radius,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirusMessage) Reset() {
+	*x = VirusMessage{}
+	mi := &file_packets_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirusMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirusMessage) ProtoMessage() {}
+
+func (x *VirusMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VirusMessage.ProtoReflect.Descriptor instead.
+func (*VirusMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *VirusMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VirusMessage) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *VirusMessage) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *VirusMessage) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+type VirusesBatchMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Viruses       []*VirusMessage        `protobuf:"bytes,1,rep,name=viruses,proto3" json:"viruses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirusesBatchMessage) Reset() {
+	*x = VirusesBatchMessage{}
+	mi := &file_packets_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirusesBatchMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirusesBatchMessage) ProtoMessage() {}
+
+func (x *VirusesBatchMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VirusesBatchMessage.ProtoReflect.Descriptor instead.
+func (*VirusesBatchMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *VirusesBatchMessage) GetViruses() []*VirusMessage {
+	if x != nil {
+		return x.Viruses
+	}
+	return nil
+}
+
+type VirusConsumedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VirusId       uint64                 `protobuf:"varint,1,opt,name=virus_id,json=virusId,proto3" json:"virus_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirusConsumedMessage) Reset() {
+	*x = VirusConsumedMessage{}
+	mi := &file_packets_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirusConsumedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirusConsumedMessage) ProtoMessage() {}
+
+func (x *VirusConsumedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VirusConsumedMessage.ProtoReflect.Descriptor instead.
+func (*VirusConsumedMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *VirusConsumedMessage) GetVirusId() uint64 {
+	if x != nil {
+		return x.VirusId
+	}
+	return 0
+}
+
+// A player ID of 0 follows whoever is currently in the lead
+type SpectateRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        uint64                 `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	PlayerId      uint64                 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectateRequestMessage) Reset() {
+	*x = SpectateRequestMessage{}
+	mi := &file_packets_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectateRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateRequestMessage) ProtoMessage() {}
+
+func (x *SpectateRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateRequestMessage.ProtoReflect.Descriptor instead.
+func (*SpectateRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SpectateRequestMessage) GetRoomId() uint64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *SpectateRequestMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+type SpectateTargetMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      uint64                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectateTargetMessage) Reset() {
+	*x = SpectateTargetMessage{}
+	mi := &file_packets_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectateTargetMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateTargetMessage) ProtoMessage() {}
+
+func (x *SpectateTargetMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateTargetMessage.ProtoReflect.Descriptor instead.
+func (*SpectateTargetMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SpectateTargetMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+type LeaderboardEntryMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          uint64                 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	PlayerId      uint64                 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Score         uint64                 `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardEntryMessage) Reset() {
+	*x = LeaderboardEntryMessage{}
+	mi := &file_packets_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntryMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntryMessage) ProtoMessage() {}
+
+func (x *LeaderboardEntryMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntryMessage.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntryMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *LeaderboardEntryMessage) GetRank() uint64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntryMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *LeaderboardEntryMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LeaderboardEntryMessage) GetScore() uint64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// The top players in the room, plus the receiving player's own entry wherever it ranks
+type LeaderboardMessage struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Entries       []*LeaderboardEntryMessage `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Own           *LeaderboardEntryMessage   `protobuf:"bytes,2,opt,name=own,proto3" json:"own,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardMessage) Reset() {
+	*x = LeaderboardMessage{}
+	mi := &file_packets_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardMessage) ProtoMessage() {}
+
+func (x *LeaderboardMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardMessage.ProtoReflect.Descriptor instead.
+func (*LeaderboardMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LeaderboardMessage) GetEntries() []*LeaderboardEntryMessage {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *LeaderboardMessage) GetOwn() *LeaderboardEntryMessage {
+	if x != nil {
+		return x.Own
+	}
+	return nil
+}
+
+type RoundCountdownMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecondsLeft   uint32                 `protobuf:"varint,1,opt,name=seconds_left,json=secondsLeft,proto3" json:"seconds_left,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoundCountdownMessage) Reset() {
+	*x = RoundCountdownMessage{}
+	mi := &file_packets_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundCountdownMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundCountdownMessage) ProtoMessage() {}
+
+func (x *RoundCountdownMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundCountdownMessage.ProtoReflect.Descriptor instead.
+func (*RoundCountdownMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RoundCountdownMessage) GetSecondsLeft() uint32 {
+	if x != nil {
+		return x.SecondsLeft
+	}
+	return 0
+}
+
+type RoundEndMessage struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Winners       []*LeaderboardEntryMessage `protobuf:"bytes,1,rep,name=winners,proto3" json:"winners,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoundEndMessage) Reset() {
+	*x = RoundEndMessage{}
+	mi := &file_packets_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundEndMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundEndMessage) ProtoMessage() {}
+
+func (x *RoundEndMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundEndMessage.ProtoReflect.Descriptor instead.
+func (*RoundEndMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RoundEndMessage) GetWinners() []*LeaderboardEntryMessage {
+	if x != nil {
+		return x.Winners
+	}
+	return nil
+}
+
+type TeamScoreMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          uint32                 `protobuf:"varint,1,opt,name=team,proto3" json:"team,omitempty"`
+	Score         uint64                 `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	PlayerCount   uint32                 `protobuf:"varint,3,opt,name=player_count,json=playerCount,proto3" json:"player_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamScoreMessage) Reset() {
+	*x = TeamScoreMessage{}
+	mi := &file_packets_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamScoreMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamScoreMessage) ProtoMessage() {}
+
+func (x *TeamScoreMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamScoreMessage.ProtoReflect.Descriptor instead.
+func (*TeamScoreMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TeamScoreMessage) GetTeam() uint32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+func (x *TeamScoreMessage) GetScore() uint64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TeamScoreMessage) GetPlayerCount() uint32 {
+	if x != nil {
+		return x.PlayerCount
+	}
+	return 0
+}
+
+type TeamScoresMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scores        []*TeamScoreMessage    `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamScoresMessage) Reset() {
+	*x = TeamScoresMessage{}
+	mi := &file_packets_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamScoresMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamScoresMessage) ProtoMessage() {}
+
+func (x *TeamScoresMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamScoresMessage.ProtoReflect.Descriptor instead.
+func (*TeamScoresMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TeamScoresMessage) GetScores() []*TeamScoreMessage {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type PowerUpMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	X             float64                `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
+	Radius        float64                `protobuf:"fixed64,4,opt,name=radius,proto3" json:"radius,omitempty"`
+	Kind          uint32                 `protobuf:"varint,5,opt,name=kind,proto3" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowerUpMessage) Reset() {
+	*x = PowerUpMessage{}
+	mi := &file_packets_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerUpMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerUpMessage) ProtoMessage() {}
+
+func (x *PowerUpMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerUpMessage.ProtoReflect.Descriptor instead.
+func (*PowerUpMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PowerUpMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PowerUpMessage) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PowerUpMessage) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *PowerUpMessage) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *PowerUpMessage) GetKind() uint32 {
+	if x != nil {
+		return x.Kind
+	}
+	return 0
+}
+
+type PowerUpsBatchMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PowerUps      []*PowerUpMessage      `protobuf:"bytes,1,rep,name=power_ups,json=powerUps,proto3" json:"power_ups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowerUpsBatchMessage) Reset() {
+	*x = PowerUpsBatchMessage{}
+	mi := &file_packets_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerUpsBatchMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerUpsBatchMessage) ProtoMessage() {}
+
+func (x *PowerUpsBatchMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerUpsBatchMessage.ProtoReflect.Descriptor instead.
+func (*PowerUpsBatchMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PowerUpsBatchMessage) GetPowerUps() []*PowerUpMessage {
+	if x != nil {
+		return x.PowerUps
+	}
+	return nil
+}
+
+type PowerUpConsumedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PowerUpId     uint64                 `protobuf:"varint,1,opt,name=power_up_id,json=powerUpId,proto3" json:"power_up_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowerUpConsumedMessage) Reset() {
+	*x = PowerUpConsumedMessage{}
+	mi := &file_packets_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerUpConsumedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerUpConsumedMessage) ProtoMessage() {}
+
+func (x *PowerUpConsumedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerUpConsumedMessage.ProtoReflect.Descriptor instead.
+func (*PowerUpConsumedMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PowerUpConsumedMessage) GetPowerUpId() uint64 {
+	if x != nil {
+		return x.PowerUpId
+	}
+	return 0
+}
+
+// A max players of 0 picks the largest size a private room can have
+type CreateRoomRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MaxPlayers    uint32                 `protobuf:"varint,2,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomRequestMessage) Reset() {
+	*x = CreateRoomRequestMessage{}
+	mi := &file_packets_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomRequestMessage) ProtoMessage() {}
+
+func (x *CreateRoomRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomRequestMessage.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateRoomRequestMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoomRequestMessage) GetMaxPlayers() uint32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+type RoomCreatedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        uint64                 `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomCreatedMessage) Reset() {
+	*x = RoomCreatedMessage{}
+	mi := &file_packets_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomCreatedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomCreatedMessage) ProtoMessage() {}
+
+func (x *RoomCreatedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomCreatedMessage.ProtoReflect.Descriptor instead.
+func (*RoomCreatedMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RoomCreatedMessage) GetRoomId() uint64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *RoomCreatedMessage) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type JoinByCodeRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinByCodeRequestMessage) Reset() {
+	*x = JoinByCodeRequestMessage{}
+	mi := &file_packets_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinByCodeRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinByCodeRequestMessage) ProtoMessage() {}
+
+func (x *JoinByCodeRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinByCodeRequestMessage.ProtoReflect.Descriptor instead.
+func (*JoinByCodeRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *JoinByCodeRequestMessage) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type KickRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      uint64                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickRequestMessage) Reset() {
+	*x = KickRequestMessage{}
+	mi := &file_packets_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickRequestMessage) ProtoMessage() {}
+
+func (x *KickRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickRequestMessage.ProtoReflect.Descriptor instead.
+func (*KickRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *KickRequestMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+// Mutes a player for the given number of seconds, or unmutes them if it is 0
+type MuteRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      uint64                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Seconds       uint32                 `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteRequestMessage) Reset() {
+	*x = MuteRequestMessage{}
+	mi := &file_packets_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteRequestMessage) ProtoMessage() {}
+
+func (x *MuteRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteRequestMessage.ProtoReflect.Descriptor instead.
+func (*MuteRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MuteRequestMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *MuteRequestMessage) GetSeconds() uint32 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+type CloseRoomRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseRoomRequestMessage) Reset() {
+	*x = CloseRoomRequestMessage{}
+	mi := &file_packets_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseRoomRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseRoomRequestMessage) ProtoMessage() {}
+
+func (x *CloseRoomRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseRoomRequestMessage.ProtoReflect.Descriptor instead.
+func (*CloseRoomRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{49}
+}
+
+type SetMaxPlayersRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxPlayers    uint32                 `protobuf:"varint,1,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMaxPlayersRequestMessage) Reset() {
+	*x = SetMaxPlayersRequestMessage{}
+	mi := &file_packets_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaxPlayersRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaxPlayersRequestMessage) ProtoMessage() {}
+
+func (x *SetMaxPlayersRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaxPlayersRequestMessage.ProtoReflect.Descriptor instead.
+func (*SetMaxPlayersRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SetMaxPlayersRequestMessage) GetMaxPlayers() uint32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+// Sent when the client is removed from its room, whether it was kicked or the room closed
+type KickedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickedMessage) Reset() {
+	*x = KickedMessage{}
+	mi := &file_packets_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickedMessage) ProtoMessage() {}
+
+func (x *KickedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickedMessage.ProtoReflect.Descriptor instead.
+func (*KickedMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *KickedMessage) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RoomOwnerMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      uint64                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomOwnerMessage) Reset() {
+	*x = RoomOwnerMessage{}
+	mi := &file_packets_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomOwnerMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomOwnerMessage) ProtoMessage() {}
+
+func (x *RoomOwnerMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomOwnerMessage.ProtoReflect.Descriptor instead.
+func (*RoomOwnerMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RoomOwnerMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+// Sent after logging in. Presenting the token from a new connection picks up where a dropped one
+// left off, as long as it is done before the server gives up on the old one.
+type ResumeTokenMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeTokenMessage) Reset() {
+	*x = ResumeTokenMessage{}
+	mi := &file_packets_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeTokenMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeTokenMessage) ProtoMessage() {}
+
+func (x *ResumeTokenMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeTokenMessage.ProtoReflect.Descriptor instead.
+func (*ResumeTokenMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ResumeTokenMessage) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ResumeRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeRequestMessage) Reset() {
+	*x = ResumeRequestMessage{}
+	mi := &file_packets_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeRequestMessage) ProtoMessage() {}
+
+func (x *ResumeRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeRequestMessage.ProtoReflect.Descriptor instead.
+func (*ResumeRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ResumeRequestMessage) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Sent after logging in. Logging in with the token instead of a password works until it expires
+// or the session is logged out.
+type SessionTokenMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix time in seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionTokenMessage) Reset() {
+	*x = SessionTokenMessage{}
+	mi := &file_packets_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionTokenMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionTokenMessage) ProtoMessage() {}
+
+func (x *SessionTokenMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionTokenMessage.ProtoReflect.Descriptor instead.
+func (*SessionTokenMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SessionTokenMessage) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SessionTokenMessage) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type TokenLoginRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenLoginRequestMessage) Reset() {
+	*x = TokenLoginRequestMessage{}
+	mi := &file_packets_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenLoginRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenLoginRequestMessage) ProtoMessage() {}
+
+func (x *TokenLoginRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenLoginRequestMessage.ProtoReflect.Descriptor instead.
+func (*TokenLoginRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TokenLoginRequestMessage) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Needs the current password as well as the new one, in case somebody else is at the keyboard.
+// Every other session of the account is logged out.
+type ChangePasswordRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OldPassword   string                 `protobuf:"bytes,1,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequestMessage) Reset() {
+	*x = ChangePasswordRequestMessage{}
+	mi := &file_packets_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequestMessage) ProtoMessage() {}
+
+func (x *ChangePasswordRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequestMessage.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ChangePasswordRequestMessage) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequestMessage) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+// Plays without registering, under a made up name. Nothing is saved for guests.
+type GuestLoginRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuestLoginRequestMessage) Reset() {
+	*x = GuestLoginRequestMessage{}
+	mi := &file_packets_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuestLoginRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestLoginRequestMessage) ProtoMessage() {}
+
+func (x *GuestLoginRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestLoginRequestMessage.ProtoReflect.Descriptor instead.
+func (*GuestLoginRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{58}
+}
+
+// Registers an account for the logged in guest, keeping its best score
+type ClaimGuestRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimGuestRequestMessage) Reset() {
+	*x = ClaimGuestRequestMessage{}
+	mi := &file_packets_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimGuestRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimGuestRequestMessage) ProtoMessage() {}
+
+func (x *ClaimGuestRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimGuestRequestMessage.ProtoReflect.Descriptor instead.
+func (*ClaimGuestRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ClaimGuestRequestMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ClaimGuestRequestMessage) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// Ends the session the client logged in with, or every session of the account
+type LogoutRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Everywhere    bool                   `protobuf:"varint,1,opt,name=everywhere,proto3" json:"everywhere,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequestMessage) Reset() {
+	*x = LogoutRequestMessage{}
+	mi := &file_packets_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequestMessage) ProtoMessage() {}
+
+func (x *LogoutRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequestMessage.ProtoReflect.Descriptor instead.
+func (*LogoutRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *LogoutRequestMessage) GetEverywhere() bool {
+	if x != nil {
+		return x.Everywhere
+	}
+	return false
+}
+
+type Packet struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SenderId uint64                 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	// Types that are valid to be assigned to Msg:
+	//
+	//	*Packet_Chat
+	//	*Packet_Id
+	//	*Packet_LoginRequest
+	//	*Packet_RegisterRequest
+	//	*Packet_OkResponse
+	//	*Packet_DenyResponse
+	//	*Packet_Player
+	//	*Packet_PlayerDirection
+	//	*Packet_Spore
+	//	*Packet_SporeConsumed
+	//	*Packet_SporesBatch
+	//	*Packet_PlayerConsumed
+	//	*Packet_HiScoreBoardRequest
+	//	*Packet_Hiscore
+	//	*Packet_HiscoreBoard
+	//	*Packet_FinishedBrowsingHiscores
+	//	*Packet_SearchHiscore
+	//	*Packet_Disconnect
+	//	*Packet_GameBounds
+	//	*Packet_WorldUpdate
+	//	*Packet_OutOfView
+	//	*Packet_RoomListRequest
+	//	*Packet_RoomList
+	//	*Packet_JoinRoomRequest
+	//	*Packet_LeaveRoomRequest
+	//	*Packet_EjectMass
+	//	*Packet_Split
+	//	*Packet_Virus
+	//	*Packet_VirusesBatch
+	//	*Packet_VirusConsumed
+	//	*Packet_SpectateRequest
+	//	*Packet_SpectateTarget
+	//	*Packet_Leaderboard
+	//	*Packet_RoundCountdown
+	//	*Packet_RoundEnd
+	//	*Packet_TeamScores
+	//	*Packet_PowerUp
+	//	*Packet_PowerUpsBatch
+	//	*Packet_PowerUpConsumed
+	//	*Packet_CreateRoomRequest
+	//	*Packet_RoomCreated
+	//	*Packet_JoinByCodeRequest
+	//	*Packet_KickRequest
+	//	*Packet_CloseRoomRequest
+	//	*Packet_SetMaxPlayersRequest
+	//	*Packet_Kicked
+	//	*Packet_RoomOwner
+	//	*Packet_ResumeToken
+	//	*Packet_ResumeRequest
+	//	*Packet_SessionToken
+	//	*Packet_TokenLoginRequest
+	//	*Packet_LogoutRequest
+	//	*Packet_ChangePasswordRequest
+	//	*Packet_GuestLoginRequest
+	//	*Packet_ClaimGuestRequest
+	//	*Packet_MuteRequest
+	Msg           isPacket_Msg `protobuf_oneof:"msg"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Packet) Reset() {
+	*x = Packet{}
+	mi := &file_packets_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Packet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Packet) ProtoMessage() {}
+
+func (x *Packet) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Packet.ProtoReflect.Descriptor instead.
+func (*Packet) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Packet) GetSenderId() uint64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *Packet) GetMsg() isPacket_Msg {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+func (x *Packet) GetChat() *ChatMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Chat); ok {
+			return x.Chat
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetId() *IdMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Id); ok {
+			return x.Id
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetLoginRequest() *LoginRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_LoginRequest); ok {
+			return x.LoginRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetRegisterRequest() *RegisterRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_RegisterRequest); ok {
+			return x.RegisterRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetOkResponse() *OkResponseMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_OkResponse); ok {
+			return x.OkResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetDenyResponse() *DenyResponseMessage {
+	if x != nil {
 		if x, ok := x.Msg.(*Packet_DenyResponse); ok {
 			return x.DenyResponse
 		}
@@ -1104,118 +3220,451 @@ func (x *Packet) GetDenyResponse() *DenyResponseMessage {
 	return nil
 }
 
-func (x *Packet) GetPlayer() *PlayerMessage {
+func (x *Packet) GetPlayer() *PlayerMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Player); ok {
+			return x.Player
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayerDirection() *PlayerDirectionMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PlayerDirection); ok {
+			return x.PlayerDirection
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSpore() *SporeMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Spore); ok {
+			return x.Spore
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSporeConsumed() *SporeConsumedMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SporeConsumed); ok {
+			return x.SporeConsumed
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSporesBatch() *SporesBatchMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SporesBatch); ok {
+			return x.SporesBatch
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayerConsumed() *PlayerConsumedMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PlayerConsumed); ok {
+			return x.PlayerConsumed
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetHiScoreBoardRequest() *HiscoreBoardRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_HiScoreBoardRequest); ok {
+			return x.HiScoreBoardRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetHiscore() *HiscoreMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Hiscore); ok {
+			return x.Hiscore
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetHiscoreBoard() *HiscoreBoardMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_HiscoreBoard); ok {
+			return x.HiscoreBoard
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetFinishedBrowsingHiscores() *FinishedBrowsingHiscoresMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_FinishedBrowsingHiscores); ok {
+			return x.FinishedBrowsingHiscores
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSearchHiscore() *SearchHiscoreMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SearchHiscore); ok {
+			return x.SearchHiscore
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetDisconnect() *DisconnectMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Disconnect); ok {
+			return x.Disconnect
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetGameBounds() *GameBoundsMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_GameBounds); ok {
+			return x.GameBounds
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetWorldUpdate() *WorldUpdateMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_WorldUpdate); ok {
+			return x.WorldUpdate
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetOutOfView() *OutOfViewMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_OutOfView); ok {
+			return x.OutOfView
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetRoomListRequest() *RoomListRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_RoomListRequest); ok {
+			return x.RoomListRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetRoomList() *RoomListMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_RoomList); ok {
+			return x.RoomList
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetJoinRoomRequest() *JoinRoomRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_JoinRoomRequest); ok {
+			return x.JoinRoomRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetLeaveRoomRequest() *LeaveRoomRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_LeaveRoomRequest); ok {
+			return x.LeaveRoomRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetEjectMass() *EjectMassMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_EjectMass); ok {
+			return x.EjectMass
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSplit() *SplitMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Split); ok {
+			return x.Split
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetVirus() *VirusMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Virus); ok {
+			return x.Virus
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetVirusesBatch() *VirusesBatchMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Player); ok {
-			return x.Player
+		if x, ok := x.Msg.(*Packet_VirusesBatch); ok {
+			return x.VirusesBatch
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetPlayerDirection() *PlayerDirectionMessage {
+func (x *Packet) GetVirusConsumed() *VirusConsumedMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_PlayerDirection); ok {
-			return x.PlayerDirection
+		if x, ok := x.Msg.(*Packet_VirusConsumed); ok {
+			return x.VirusConsumed
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetSpore() *SporeMessage {
+func (x *Packet) GetSpectateRequest() *SpectateRequestMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Spore); ok {
-			return x.Spore
+		if x, ok := x.Msg.(*Packet_SpectateRequest); ok {
+			return x.SpectateRequest
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetSporeConsumed() *SporeConsumedMessage {
+func (x *Packet) GetSpectateTarget() *SpectateTargetMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_SporeConsumed); ok {
-			return x.SporeConsumed
+		if x, ok := x.Msg.(*Packet_SpectateTarget); ok {
+			return x.SpectateTarget
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetSporesBatch() *SporesBatchMessage {
+func (x *Packet) GetLeaderboard() *LeaderboardMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_SporesBatch); ok {
-			return x.SporesBatch
+		if x, ok := x.Msg.(*Packet_Leaderboard); ok {
+			return x.Leaderboard
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetPlayerConsumed() *PlayerConsumedMessage {
+func (x *Packet) GetRoundCountdown() *RoundCountdownMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_PlayerConsumed); ok {
-			return x.PlayerConsumed
+		if x, ok := x.Msg.(*Packet_RoundCountdown); ok {
+			return x.RoundCountdown
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetHiScoreBoardRequest() *HiscoreBoardRequestMessage {
+func (x *Packet) GetRoundEnd() *RoundEndMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_HiScoreBoardRequest); ok {
-			return x.HiScoreBoardRequest
+		if x, ok := x.Msg.(*Packet_RoundEnd); ok {
+			return x.RoundEnd
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetHiscore() *HiscoreMessage {
+func (x *Packet) GetTeamScores() *TeamScoresMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Hiscore); ok {
-			return x.Hiscore
+		if x, ok := x.Msg.(*Packet_TeamScores); ok {
+			return x.TeamScores
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetHiscoreBoard() *HiscoreBoardMessage {
+func (x *Packet) GetPowerUp() *PowerUpMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_HiscoreBoard); ok {
-			return x.HiscoreBoard
+		if x, ok := x.Msg.(*Packet_PowerUp); ok {
+			return x.PowerUp
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetFinishedBrowsingHiscores() *FinishedBrowsingHiscoresMessage {
+func (x *Packet) GetPowerUpsBatch() *PowerUpsBatchMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_FinishedBrowsingHiscores); ok {
-			return x.FinishedBrowsingHiscores
+		if x, ok := x.Msg.(*Packet_PowerUpsBatch); ok {
+			return x.PowerUpsBatch
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetSearchHiscore() *SearchHiscoreMessage {
+func (x *Packet) GetPowerUpConsumed() *PowerUpConsumedMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_SearchHiscore); ok {
-			return x.SearchHiscore
+		if x, ok := x.Msg.(*Packet_PowerUpConsumed); ok {
+			return x.PowerUpConsumed
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetDisconnect() *DisconnectMessage {
+func (x *Packet) GetCreateRoomRequest() *CreateRoomRequestMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Disconnect); ok {
-			return x.Disconnect
+		if x, ok := x.Msg.(*Packet_CreateRoomRequest); ok {
+			return x.CreateRoomRequest
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetGameBounds() *GameBoundsMessage {
+func (x *Packet) GetRoomCreated() *RoomCreatedMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_GameBounds); ok {
-			return x.GameBounds
+		if x, ok := x.Msg.(*Packet_RoomCreated); ok {
+			return x.RoomCreated
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetJoinByCodeRequest() *JoinByCodeRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_JoinByCodeRequest); ok {
+			return x.JoinByCodeRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetKickRequest() *KickRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_KickRequest); ok {
+			return x.KickRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetCloseRoomRequest() *CloseRoomRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_CloseRoomRequest); ok {
+			return x.CloseRoomRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSetMaxPlayersRequest() *SetMaxPlayersRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SetMaxPlayersRequest); ok {
+			return x.SetMaxPlayersRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetKicked() *KickedMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Kicked); ok {
+			return x.Kicked
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetRoomOwner() *RoomOwnerMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_RoomOwner); ok {
+			return x.RoomOwner
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetResumeToken() *ResumeTokenMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ResumeToken); ok {
+			return x.ResumeToken
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetResumeRequest() *ResumeRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ResumeRequest); ok {
+			return x.ResumeRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSessionToken() *SessionTokenMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SessionToken); ok {
+			return x.SessionToken
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetTokenLoginRequest() *TokenLoginRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_TokenLoginRequest); ok {
+			return x.TokenLoginRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetLogoutRequest() *LogoutRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_LogoutRequest); ok {
+			return x.LogoutRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetChangePasswordRequest() *ChangePasswordRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ChangePasswordRequest); ok {
+			return x.ChangePasswordRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetGuestLoginRequest() *GuestLoginRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_GuestLoginRequest); ok {
+			return x.GuestLoginRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetClaimGuestRequest() *ClaimGuestRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ClaimGuestRequest); ok {
+			return x.ClaimGuestRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetMuteRequest() *MuteRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_MuteRequest); ok {
+			return x.MuteRequest
 		}
 	}
 	return nil
@@ -1301,6 +3750,154 @@ type Packet_GameBounds struct {
 	GameBounds *GameBoundsMessage `protobuf:"bytes,20,opt,name=game_bounds,json=gameBounds,proto3,oneof"`
 }
 
+type Packet_WorldUpdate struct {
+	WorldUpdate *WorldUpdateMessage `protobuf:"bytes,21,opt,name=world_update,json=worldUpdate,proto3,oneof"`
+}
+
+type Packet_OutOfView struct {
+	OutOfView *OutOfViewMessage `protobuf:"bytes,22,opt,name=out_of_view,json=outOfView,proto3,oneof"`
+}
+
+type Packet_RoomListRequest struct {
+	RoomListRequest *RoomListRequestMessage `protobuf:"bytes,23,opt,name=room_list_request,json=roomListRequest,proto3,oneof"`
+}
+
+type Packet_RoomList struct {
+	RoomList *RoomListMessage `protobuf:"bytes,24,opt,name=room_list,json=roomList,proto3,oneof"`
+}
+
+type Packet_JoinRoomRequest struct {
+	JoinRoomRequest *JoinRoomRequestMessage `protobuf:"bytes,25,opt,name=join_room_request,json=joinRoomRequest,proto3,oneof"`
+}
+
+type Packet_LeaveRoomRequest struct {
+	LeaveRoomRequest *LeaveRoomRequestMessage `protobuf:"bytes,26,opt,name=leave_room_request,json=leaveRoomRequest,proto3,oneof"`
+}
+
+type Packet_EjectMass struct {
+	EjectMass *EjectMassMessage `protobuf:"bytes,27,opt,name=eject_mass,json=ejectMass,proto3,oneof"`
+}
+
+type Packet_Split struct {
+	Split *SplitMessage `protobuf:"bytes,28,opt,name=split,proto3,oneof"`
+}
+
+type Packet_Virus struct {
+	Virus *VirusMessage `protobuf:"bytes,29,opt,name=virus,proto3,oneof"`
+}
+
+type Packet_VirusesBatch struct {
+	VirusesBatch *VirusesBatchMessage `protobuf:"bytes,30,opt,name=viruses_batch,json=virusesBatch,proto3,oneof"`
+}
+
+type Packet_VirusConsumed struct {
+	VirusConsumed *VirusConsumedMessage `protobuf:"bytes,31,opt,name=virus_consumed,json=virusConsumed,proto3,oneof"`
+}
+
+type Packet_SpectateRequest struct {
+	SpectateRequest *SpectateRequestMessage `protobuf:"bytes,32,opt,name=spectate_request,json=spectateRequest,proto3,oneof"`
+}
+
+type Packet_SpectateTarget struct {
+	SpectateTarget *SpectateTargetMessage `protobuf:"bytes,33,opt,name=spectate_target,json=spectateTarget,proto3,oneof"`
+}
+
+type Packet_Leaderboard struct {
+	Leaderboard *LeaderboardMessage `protobuf:"bytes,34,opt,name=leaderboard,proto3,oneof"`
+}
+
+type Packet_RoundCountdown struct {
+	RoundCountdown *RoundCountdownMessage `protobuf:"bytes,35,opt,name=round_countdown,json=roundCountdown,proto3,oneof"`
+}
+
+type Packet_RoundEnd struct {
+	RoundEnd *RoundEndMessage `protobuf:"bytes,36,opt,name=round_end,json=roundEnd,proto3,oneof"`
+}
+
+type Packet_TeamScores struct {
+	TeamScores *TeamScoresMessage `protobuf:"bytes,37,opt,name=team_scores,json=teamScores,proto3,oneof"`
+}
+
+type Packet_PowerUp struct {
+	PowerUp *PowerUpMessage `protobuf:"bytes,38,opt,name=power_up,json=powerUp,proto3,oneof"`
+}
+
+type Packet_PowerUpsBatch struct {
+	PowerUpsBatch *PowerUpsBatchMessage `protobuf:"bytes,39,opt,name=power_ups_batch,json=powerUpsBatch,proto3,oneof"`
+}
+
+type Packet_PowerUpConsumed struct {
+	PowerUpConsumed *PowerUpConsumedMessage `protobuf:"bytes,40,opt,name=power_up_consumed,json=powerUpConsumed,proto3,oneof"`
+}
+
+type Packet_CreateRoomRequest struct {
+	CreateRoomRequest *CreateRoomRequestMessage `protobuf:"bytes,41,opt,name=create_room_request,json=createRoomRequest,proto3,oneof"`
+}
+
+type Packet_RoomCreated struct {
+	RoomCreated *RoomCreatedMessage `protobuf:"bytes,42,opt,name=room_created,json=roomCreated,proto3,oneof"`
+}
+
+type Packet_JoinByCodeRequest struct {
+	JoinByCodeRequest *JoinByCodeRequestMessage `protobuf:"bytes,43,opt,name=join_by_code_request,json=joinByCodeRequest,proto3,oneof"`
+}
+
+type Packet_KickRequest struct {
+	KickRequest *KickRequestMessage `protobuf:"bytes,44,opt,name=kick_request,json=kickRequest,proto3,oneof"`
+}
+
+type Packet_CloseRoomRequest struct {
+	CloseRoomRequest *CloseRoomRequestMessage `protobuf:"bytes,45,opt,name=close_room_request,json=closeRoomRequest,proto3,oneof"`
+}
+
+type Packet_SetMaxPlayersRequest struct {
+	SetMaxPlayersRequest *SetMaxPlayersRequestMessage `protobuf:"bytes,46,opt,name=set_max_players_request,json=setMaxPlayersRequest,proto3,oneof"`
+}
+
+type Packet_Kicked struct {
+	Kicked *KickedMessage `protobuf:"bytes,47,opt,name=kicked,proto3,oneof"`
+}
+
+type Packet_RoomOwner struct {
+	RoomOwner *RoomOwnerMessage `protobuf:"bytes,48,opt,name=room_owner,json=roomOwner,proto3,oneof"`
+}
+
+type Packet_ResumeToken struct {
+	ResumeToken *ResumeTokenMessage `protobuf:"bytes,49,opt,name=resume_token,json=resumeToken,proto3,oneof"`
+}
+
+type Packet_ResumeRequest struct {
+	ResumeRequest *ResumeRequestMessage `protobuf:"bytes,50,opt,name=resume_request,json=resumeRequest,proto3,oneof"`
+}
+
+type Packet_SessionToken struct {
+	SessionToken *SessionTokenMessage `protobuf:"bytes,51,opt,name=session_token,json=sessionToken,proto3,oneof"`
+}
+
+type Packet_TokenLoginRequest struct {
+	TokenLoginRequest *TokenLoginRequestMessage `protobuf:"bytes,52,opt,name=token_login_request,json=tokenLoginRequest,proto3,oneof"`
+}
+
+type Packet_LogoutRequest struct {
+	LogoutRequest *LogoutRequestMessage `protobuf:"bytes,53,opt,name=logout_request,json=logoutRequest,proto3,oneof"`
+}
+
+type Packet_ChangePasswordRequest struct {
+	ChangePasswordRequest *ChangePasswordRequestMessage `protobuf:"bytes,54,opt,name=change_password_request,json=changePasswordRequest,proto3,oneof"`
+}
+
+type Packet_GuestLoginRequest struct {
+	GuestLoginRequest *GuestLoginRequestMessage `protobuf:"bytes,55,opt,name=guest_login_request,json=guestLoginRequest,proto3,oneof"`
+}
+
+type Packet_ClaimGuestRequest struct {
+	ClaimGuestRequest *ClaimGuestRequestMessage `protobuf:"bytes,56,opt,name=claim_guest_request,json=claimGuestRequest,proto3,oneof"`
+}
+
+type Packet_MuteRequest struct {
+	MuteRequest *MuteRequestMessage `protobuf:"bytes,57,opt,name=mute_request,json=muteRequest,proto3,oneof"`
+}
+
 func (*Packet_Chat) isPacket_Msg() {}
 
 func (*Packet_Id) isPacket_Msg() {}
@@ -1339,6 +3936,80 @@ func (*Packet_Disconnect) isPacket_Msg() {}
 
 func (*Packet_GameBounds) isPacket_Msg() {}
 
+func (*Packet_WorldUpdate) isPacket_Msg() {}
+
+func (*Packet_OutOfView) isPacket_Msg() {}
+
+func (*Packet_RoomListRequest) isPacket_Msg() {}
+
+func (*Packet_RoomList) isPacket_Msg() {}
+
+func (*Packet_JoinRoomRequest) isPacket_Msg() {}
+
+func (*Packet_LeaveRoomRequest) isPacket_Msg() {}
+
+func (*Packet_EjectMass) isPacket_Msg() {}
+
+func (*Packet_Split) isPacket_Msg() {}
+
+func (*Packet_Virus) isPacket_Msg() {}
+
+func (*Packet_VirusesBatch) isPacket_Msg() {}
+
+func (*Packet_VirusConsumed) isPacket_Msg() {}
+
+func (*Packet_SpectateRequest) isPacket_Msg() {}
+
+func (*Packet_SpectateTarget) isPacket_Msg() {}
+
+func (*Packet_Leaderboard) isPacket_Msg() {}
+
+func (*Packet_RoundCountdown) isPacket_Msg() {}
+
+func (*Packet_RoundEnd) isPacket_Msg() {}
+
+func (*Packet_TeamScores) isPacket_Msg() {}
+
+func (*Packet_PowerUp) isPacket_Msg() {}
+
+func (*Packet_PowerUpsBatch) isPacket_Msg() {}
+
+func (*Packet_PowerUpConsumed) isPacket_Msg() {}
+
+func (*Packet_CreateRoomRequest) isPacket_Msg() {}
+
+func (*Packet_RoomCreated) isPacket_Msg() {}
+
+func (*Packet_JoinByCodeRequest) isPacket_Msg() {}
+
+func (*Packet_KickRequest) isPacket_Msg() {}
+
+func (*Packet_CloseRoomRequest) isPacket_Msg() {}
+
+func (*Packet_SetMaxPlayersRequest) isPacket_Msg() {}
+
+func (*Packet_Kicked) isPacket_Msg() {}
+
+func (*Packet_RoomOwner) isPacket_Msg() {}
+
+func (*Packet_ResumeToken) isPacket_Msg() {}
+
+func (*Packet_ResumeRequest) isPacket_Msg() {}
+
+func (*Packet_SessionToken) isPacket_Msg() {}
+
+func (*Packet_TokenLoginRequest) isPacket_Msg() {}
+
+func (*Packet_LogoutRequest) isPacket_Msg() {}
+
+func (*Packet_ChangePasswordRequest) isPacket_Msg() {}
+
+func (*Packet_GuestLoginRequest) isPacket_Msg() {}
+
+func (*Packet_ClaimGuestRequest) isPacket_Msg() {}
+
+func (*Packet_MuteRequest) isPacket_Msg() {}
+
 var File_packets_proto protoreflect.FileDescriptor
 
 const file_packets_proto_rawDesc = "" +
@@ -1357,7 +4028,7 @@ const file_packets_proto_rawDesc = "" +
 	"\x05color\x18\x03 \x01(\x05R\x05color\"\x13\n" +
 	"\x11OkResponseMessage\"-\n" +
 	"\x13DenyResponseMessage\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xb1\x01\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xad\x02\n" +
 	"\rPlayerMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\f\n" +
@@ -1366,7 +4037,18 @@ const file_packets_proto_rawDesc = "" +
 	"\x06radius\x18\x05 \x01(\x01R\x06radius\x12\x1c\n" +
 	"\tdirection\x18\x06 \x01(\x01R\tdirection\x12\x14\n" +
 	"\x05speed\x18\a \x01(\x01R\x05speed\x12\x14\n" +
-	"\x05color\x18\b \x01(\x05R\x05color\"6\n" +
+	"\x05color\x18\b \x01(\x05R\x05color\x12*\n" +
+	"\x05cells\x18\t \x03(\v2\x14.packets.CellMessageR\x05cells\x12\x12\n" +
+	"\x04team\x18\n" +
+	" \x01(\rR\x04team\x12:\n" +
+	"\tpower_ups\x18\v \x03(\v2\x1d.packets.ActivePowerUpMessageR\bpowerUps\"A\n" +
+	"\vCellMessage\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x01R\x01y\x12\x16\n" +
+	"\x06radius\x18\x03 \x01(\x01R\x06radius\"M\n" +
+	"\x14ActivePowerUpMessage\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\rR\x04kind\x12!\n" +
+	"\fseconds_left\x18\x02 \x01(\x01R\vsecondsLeft\"6\n" +
 	"\x16PlayerDirectionMessage\x12\x1c\n" +
 	"\tdirection\x18\x01 \x01(\x01R\tdirection\"R\n" +
 	"\fSporeMessage\x12\x0e\n" +
@@ -1396,8 +4078,116 @@ const file_packets_proto_rawDesc = "" +
 	"\x05min_x\x18\x01 \x01(\x01R\x04minX\x12\x13\n" +
 	"\x05max_x\x18\x02 \x01(\x01R\x04maxX\x12\x13\n" +
 	"\x05min_y\x18\x03 \x01(\x01R\x04minY\x12\x13\n" +
-	"\x05max_y\x18\x04 \x01(\x01R\x04maxY\"\x9e\n" +
+	"\x05max_y\x18\x04 \x01(\x01R\x04maxY\"u\n" +
+	"\x12WorldUpdateMessage\x120\n" +
+	"\aplayers\x18\x01 \x03(\v2\x16.packets.PlayerMessageR\aplayers\x12-\n" +
+	"\x06spores\x18\x02 \x03(\v2\x15.packets.SporeMessageR\x06spores\"\x8d\x01\n" +
+	"\x10OutOfViewMessage\x12\x1d\n" +
+	"\n" +
+	"player_ids\x18\x01 \x03(\x04R\tplayerIds\x12\x1b\n" +
+	"\tspore_ids\x18\x02 \x03(\x04R\bsporeIds\x12\x1b\n" +
+	"\tvirus_ids\x18\x03 \x03(\x04R\bvirusIds\x12 \n" +
+	"\fpower_up_ids\x18\x04 \x03(\x04R\n" +
+	"powerUpIds\"u\n" +
+	"\vRoomMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\fplayer_count\x18\x03 \x01(\rR\vplayerCount\x12\x1f\n" +
+	"\vmax_players\x18\x04 \x01(\rR\n" +
+	"maxPlayers\"\x18\n" +
+	"\x16RoomListRequestMessage\"=\n" +
+	"\x0fRoomListMessage\x12*\n" +
+	"\x05rooms\x18\x01 \x03(\v2\x14.packets.RoomMessageR\x05rooms\"1\n" +
+	"\x16JoinRoomRequestMessage\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x04R\x06roomId\"\x19\n" +
+	"\x17LeaveRoomRequestMessage\"\x12\n" +
+	"\x10EjectMassMessage\"\x0e\n" +
+	"\fSplitMessage\"R\n" +
+	"\fVirusMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\f\n" +
+	"\x01x\x18\x02 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x03 \x01(\x01R\x01y\x12\x16\n" +
+	"\x06radius\x18\x04 \x01(\x01R\x06radius\"F\n" +
+	"\x13VirusesBatchMessage\x12/\n" +
+	"\aviruses\x18\x01 \x03(\v2\x15.packets.VirusMessageR\aviruses\"1\n" +
+	"\x14VirusConsumedMessage\x12\x19\n" +
+	"\bvirus_id\x18\x01 \x01(\x04R\avirusId\"N\n" +
+	"\x16SpectateRequestMessage\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x04R\x06roomId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\x04R\bplayerId\"4\n" +
+	"\x15SpectateTargetMessage\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x04R\bplayerId\"t\n" +
+	"\x17LeaderboardEntryMessage\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x04R\x04rank\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\x04R\bplayerId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x04R\x05score\"\x84\x01\n" +
+	"\x12LeaderboardMessage\x12:\n" +
+	"\aentries\x18\x01 \x03(\v2 .packets.LeaderboardEntryMessageR\aentries\x122\n" +
+	"\x03own\x18\x02 \x01(\v2 .packets.LeaderboardEntryMessageR\x03own\":\n" +
+	"\x15RoundCountdownMessage\x12!\n" +
+	"\fseconds_left\x18\x01 \x01(\rR\vsecondsLeft\"M\n" +
+	"\x0fRoundEndMessage\x12:\n" +
+	"\awinners\x18\x01 \x03(\v2 .packets.LeaderboardEntryMessageR\awinners\"_\n" +
+	"\x10TeamScoreMessage\x12\x12\n" +
+	"\x04team\x18\x01 \x01(\rR\x04team\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x04R\x05score\x12!\n" +
+	"\fplayer_count\x18\x03 \x01(\rR\vplayerCount\"F\n" +
+	"\x11TeamScoresMessage\x121\n" +
+	"\x06scores\x18\x01 \x03(\v2\x19.packets.TeamScoreMessageR\x06scores\"h\n" +
+	"\x0ePowerUpMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\f\n" +
+	"\x01x\x18\x02 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x03 \x01(\x01R\x01y\x12\x16\n" +
+	"\x06radius\x18\x04 \x01(\x01R\x06radius\x12\x12\n" +
+	"\x04kind\x18\x05 \x01(\rR\x04kind\"L\n" +
+	"\x14PowerUpsBatchMessage\x124\n" +
+	"\tpower_ups\x18\x01 \x03(\v2\x17.packets.PowerUpMessageR\bpowerUps\"8\n" +
+	"\x16PowerUpConsumedMessage\x12\x1e\n" +
+	"\vpower_up_id\x18\x01 \x01(\x04R\tpowerUpId\"O\n" +
+	"\x18CreateRoomRequestMessage\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vmax_players\x18\x02 \x01(\rR\n" +
+	"maxPlayers\"A\n" +
+	"\x12RoomCreatedMessage\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x04R\x06roomId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\".\n" +
+	"\x18JoinByCodeRequestMessage\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"1\n" +
+	"\x12KickRequestMessage\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x04R\bplayerId\"K\n" +
+	"\x12MuteRequestMessage\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x04R\bplayerId\x12\x18\n" +
+	"\aseconds\x18\x02 \x01(\rR\aseconds\"\x19\n" +
+	"\x17CloseRoomRequestMessage\">\n" +
+	"\x1bSetMaxPlayersRequestMessage\x12\x1f\n" +
+	"\vmax_players\x18\x01 \x01(\rR\n" +
+	"maxPlayers\"'\n" +
+	"\rKickedMessage\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\"/\n" +
+	"\x10RoomOwnerMessage\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x04R\bplayerId\"*\n" +
+	"\x12ResumeTokenMessage\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\",\n" +
+	"\x14ResumeRequestMessage\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"J\n" +
+	"\x13SessionTokenMessage\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
 	"\n" +
+	"expires_at\x18\x02 \x01(\x03R\texpiresAt\"0\n" +
+	"\x18TokenLoginRequestMessage\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"d\n" +
+	"\x1cChangePasswordRequestMessage\x12!\n" +
+	"\fold_password\x18\x01 \x01(\tR\voldPassword\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"\x1a\n" +
+	"\x18GuestLoginRequestMessage\"R\n" +
+	"\x18ClaimGuestRequestMessage\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"6\n" +
+	"\x14LogoutRequestMessage\x12\x1e\n" +
+	"\n" +
+	"everywhere\x18\x01 \x01(\bR\n" +
+	"everywhere\"\xdf\x1e\n" +
 	"\x06Packet\x12\x1b\n" +
 	"\tsender_id\x18\x01 \x01(\x04R\bsenderId\x12*\n" +
 	"\x04chat\x18\x02 \x01(\v2\x14.packets.ChatMessageH\x00R\x04chat\x12$\n" +
@@ -1423,7 +4213,47 @@ const file_packets_proto_rawDesc = "" +
 	"disconnect\x18\x13 \x01(\v2\x1a.packets.DisconnectMessageH\x00R\n" +
 	"disconnect\x12=\n" +
 	"\vgame_bounds\x18\x14 \x01(\v2\x1a.packets.GameBoundsMessageH\x00R\n" +
-	"gameBoundsB\x05\n" +
+	"gameBounds\x12@\n" +
+	"\fworld_update\x18\x15 \x01(\v2\x1b.packets.WorldUpdateMessageH\x00R\vworldUpdate\x12;\n" +
+	"\vout_of_view\x18\x16 \x01(\v2\x19.packets.OutOfViewMessageH\x00R\toutOfView\x12M\n" +
+	"\x11room_list_request\x18\x17 \x01(\v2\x1f.packets.RoomListRequestMessageH\x00R\x0froomListRequest\x127\n" +
+	"\troom_list\x18\x18 \x01(\v2\x18.packets.RoomListMessageH\x00R\broomList\x12M\n" +
+	"\x11join_room_request\x18\x19 \x01(\v2\x1f.packets.JoinRoomRequestMessageH\x00R\x0fjoinRoomRequest\x12P\n" +
+	"\x12leave_room_request\x18\x1a \x01(\v2 .packets.LeaveRoomRequestMessageH\x00R\x10leaveRoomRequest\x12:\n" +
+	"\n" +
+	"eject_mass\x18\x1b \x01(\v2\x19.packets.EjectMassMessageH\x00R\tejectMass\x12-\n" +
+	"\x05split\x18\x1c \x01(\v2\x15.packets.SplitMessageH\x00R\x05split\x12-\n" +
+	"\x05virus\x18\x1d \x01(\v2\x15.packets.VirusMessageH\x00R\x05virus\x12C\n" +
+	"\rviruses_batch\x18\x1e \x01(\v2\x1c.packets.VirusesBatchMessageH\x00R\fvirusesBatch\x12F\n" +
+	"\x0evirus_consumed\x18\x1f \x01(\v2\x1d.packets.VirusConsumedMessageH\x00R\rvirusConsumed\x12L\n" +
+	"\x10spectate_request\x18  \x01(\v2\x1f.packets.SpectateRequestMessageH\x00R\x0fspectateRequest\x12I\n" +
+	"\x0fspectate_target\x18! \x01(\v2\x1e.packets.SpectateTargetMessageH\x00R\x0espectateTarget\x12?\n" +
+	"\vleaderboard\x18\" \x01(\v2\x1b.packets.LeaderboardMessageH\x00R\vleaderboard\x12I\n" +
+	"\x0fround_countdown\x18# \x01(\v2\x1e.packets.RoundCountdownMessageH\x00R\x0eroundCountdown\x127\n" +
+	"\tround_end\x18$ \x01(\v2\x18.packets.RoundEndMessageH\x00R\broundEnd\x12=\n" +
+	"\vteam_scores\x18% \x01(\v2\x1a.packets.TeamScoresMessageH\x00R\n" +
+	"teamScores\x124\n" +
+	"\bpower_up\x18& \x01(\v2\x17.packets.PowerUpMessageH\x00R\apowerUp\x12G\n" +
+	"\x0fpower_ups_batch\x18' \x01(\v2\x1d.packets.PowerUpsBatchMessageH\x00R\rpowerUpsBatch\x12M\n" +
+	"\x11power_up_consumed\x18( \x01(\v2\x1f.packets.PowerUpConsumedMessageH\x00R\x0fpowerUpConsumed\x12S\n" +
+	"\x13create_room_request\x18) \x01(\v2!.packets.CreateRoomRequestMessageH\x00R\x11createRoomRequest\x12@\n" +
+	"\froom_created\x18* \x01(\v2\x1b.packets.RoomCreatedMessageH\x00R\vroomCreated\x12T\n" +
+	"\x14join_by_code_request\x18+ \x01(\v2!.packets.JoinByCodeRequestMessageH\x00R\x11joinByCodeRequest\x12@\n" +
+	"\fkick_request\x18, \x01(\v2\x1b.packets.KickRequestMessageH\x00R\vkickRequest\x12P\n" +
+	"\x12close_room_request\x18- \x01(\v2 .packets.CloseRoomRequestMessageH\x00R\x10closeRoomRequest\x12]\n" +
+	"\x17set_max_players_request\x18. \x01(\v2$.packets.SetMaxPlayersRequestMessageH\x00R\x14setMaxPlayersRequest\x120\n" +
+	"\x06kicked\x18/ \x01(\v2\x16.packets.KickedMessageH\x00R\x06kicked\x12:\n" +
+	"\n" +
+	"room_owner\x180 \x01(\v2\x19.packets.RoomOwnerMessageH\x00R\troomOwner\x12@\n" +
+	"\fresume_token\x181 \x01(\v2\x1b.packets.ResumeTokenMessageH\x00R\vresumeToken\x12F\n" +
+	"\x0eresume_request\x182 \x01(\v2\x1d.packets.ResumeRequestMessageH\x00R\rresumeRequest\x12C\n" +
+	"\rsession_token\x183 \x01(\v2\x1c.packets.SessionTokenMessageH\x00R\fsessionToken\x12S\n" +
+	"\x13token_login_request\x184 \x01(\v2!.packets.TokenLoginRequestMessageH\x00R\x11tokenLoginRequest\x12F\n" +
+	"\x0elogout_request\x185 \x01(\v2\x1d.packets.LogoutRequestMessageH\x00R\rlogoutRequest\x12_\n" +
+	"\x17change_password_request\x186 \x01(\v2%.packets.ChangePasswordRequestMessageH\x00R\x15changePasswordRequest\x12S\n" +
+	"\x13guest_login_request\x187 \x01(\v2!.packets.GuestLoginRequestMessageH\x00R\x11guestLoginRequest\x12S\n" +
+	"\x13claim_guest_request\x188 \x01(\v2!.packets.ClaimGuestRequestMessageH\x00R\x11claimGuestRequest\x12@\n" +
+	"\fmute_request\x189 \x01(\v2\x1b.packets.MuteRequestMessageH\x00R\vmuteRequestB\x05\n" +
 	"\x03msgB\rZ\vpkg/packetsb\x06proto3"
 
 var (
@@ -1438,7 +4268,7 @@ func file_packets_proto_rawDescGZIP() []byte {
 	return file_packets_proto_rawDescData
 }
 
-var file_packets_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_packets_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
 var file_packets_proto_goTypes = []any{
 	(*ChatMessage)(nil),                     // 0: packets.ChatMessage
 	(*IdMessage)(nil),                       // 1: packets.IdMessage
@@ -1447,47 +4277,137 @@ var file_packets_proto_goTypes = []any{
 	(*OkResponseMessage)(nil),               // 4: packets.OkResponseMessage
 	(*DenyResponseMessage)(nil),             // 5: packets.DenyResponseMessage
 	(*PlayerMessage)(nil),                   // 6: packets.PlayerMessage
-	(*PlayerDirectionMessage)(nil),          // 7: packets.PlayerDirectionMessage
-	(*SporeMessage)(nil),                    // 8: packets.SporeMessage
-	(*SporeConsumedMessage)(nil),            // 9: packets.SporeConsumedMessage
-	(*SporesBatchMessage)(nil),              // 10: packets.SporesBatchMessage
-	(*PlayerConsumedMessage)(nil),           // 11: packets.PlayerConsumedMessage
-	(*HiscoreBoardRequestMessage)(nil),      // 12: packets.HiscoreBoardRequestMessage
-	(*HiscoreMessage)(nil),                  // 13: packets.HiscoreMessage
-	(*HiscoreBoardMessage)(nil),             // 14: packets.HiscoreBoardMessage
-	(*FinishedBrowsingHiscoresMessage)(nil), // 15: packets.FinishedBrowsingHiscoresMessage
-	(*SearchHiscoreMessage)(nil),            // 16: packets.SearchHiscoreMessage
-	(*DisconnectMessage)(nil),               // 17: packets.DisconnectMessage
-	(*GameBoundsMessage)(nil),               // 18: packets.GameBoundsMessage
-	(*Packet)(nil),                          // 19: packets.Packet
+	(*CellMessage)(nil),                     // 7: packets.CellMessage
+	(*ActivePowerUpMessage)(nil),            // 8: packets.ActivePowerUpMessage
+	(*PlayerDirectionMessage)(nil),          // 9: packets.PlayerDirectionMessage
+	(*SporeMessage)(nil),                    // 10: packets.SporeMessage
+	(*SporeConsumedMessage)(nil),            // 11: packets.SporeConsumedMessage
+	(*SporesBatchMessage)(nil),              // 12: packets.SporesBatchMessage
+	(*PlayerConsumedMessage)(nil),           // 13: packets.PlayerConsumedMessage
+	(*HiscoreBoardRequestMessage)(nil),      // 14: packets.HiscoreBoardRequestMessage
+	(*HiscoreMessage)(nil),                  // 15: packets.HiscoreMessage
+	(*HiscoreBoardMessage)(nil),             // 16: packets.HiscoreBoardMessage
+	(*FinishedBrowsingHiscoresMessage)(nil), // 17: packets.FinishedBrowsingHiscoresMessage
+	(*SearchHiscoreMessage)(nil),            // 18: packets.SearchHiscoreMessage
+	(*DisconnectMessage)(nil),               // 19: packets.DisconnectMessage
+	(*GameBoundsMessage)(nil),               // 20: packets.GameBoundsMessage
+	(*WorldUpdateMessage)(nil),              // 21: packets.WorldUpdateMessage
+	(*OutOfViewMessage)(nil),                // 22: packets.OutOfViewMessage
+	(*RoomMessage)(nil),                     // 23: packets.RoomMessage
+	(*RoomListRequestMessage)(nil),          // 24: packets.RoomListRequestMessage
+	(*RoomListMessage)(nil),                 // 25: packets.RoomListMessage
+	(*JoinRoomRequestMessage)(nil),          // 26: packets.JoinRoomRequestMessage
+	(*LeaveRoomRequestMessage)(nil),         // 27: packets.LeaveRoomRequestMessage
+	(*EjectMassMessage)(nil),                // 28: packets.EjectMassMessage
+	(*SplitMessage)(nil),                    // 29: packets.SplitMessage
+	(*VirusMessage)(nil),                    // 30: packets.VirusMessage
+	(*VirusesBatchMessage)(nil),             // 31: packets.VirusesBatchMessage
+	(*VirusConsumedMessage)(nil),            // 32: packets.VirusConsumedMessage
+	(*SpectateRequestMessage)(nil),          // 33: packets.SpectateRequestMessage
+	(*SpectateTargetMessage)(nil),           // 34: packets.SpectateTargetMessage
+	(*LeaderboardEntryMessage)(nil),         // 35: packets.LeaderboardEntryMessage
+	(*LeaderboardMessage)(nil),              // 36: packets.LeaderboardMessage
+	(*RoundCountdownMessage)(nil),           // 37: packets.RoundCountdownMessage
+	(*RoundEndMessage)(nil),                 // 38: packets.RoundEndMessage
+	(*TeamScoreMessage)(nil),                // 39: packets.TeamScoreMessage
+	(*TeamScoresMessage)(nil),               // 40: packets.TeamScoresMessage
+	(*PowerUpMessage)(nil),                  // 41: packets.PowerUpMessage
+	(*PowerUpsBatchMessage)(nil),            // 42: packets.PowerUpsBatchMessage
+	(*PowerUpConsumedMessage)(nil),          // 43: packets.PowerUpConsumedMessage
+	(*CreateRoomRequestMessage)(nil),        // 44: packets.CreateRoomRequestMessage
+	(*RoomCreatedMessage)(nil),              // 45: packets.RoomCreatedMessage
+	(*JoinByCodeRequestMessage)(nil),        // 46: packets.JoinByCodeRequestMessage
+	(*KickRequestMessage)(nil),              // 47: packets.KickRequestMessage
+	(*MuteRequestMessage)(nil),              // 48: packets.MuteRequestMessage
+	(*CloseRoomRequestMessage)(nil),         // 49: packets.CloseRoomRequestMessage
+	(*SetMaxPlayersRequestMessage)(nil),     // 50: packets.SetMaxPlayersRequestMessage
+	(*KickedMessage)(nil),                   // 51: packets.KickedMessage
+	(*RoomOwnerMessage)(nil),                // 52: packets.RoomOwnerMessage
+	(*ResumeTokenMessage)(nil),              // 53: packets.ResumeTokenMessage
+	(*ResumeRequestMessage)(nil),            // 54: packets.ResumeRequestMessage
+	(*SessionTokenMessage)(nil),             // 55: packets.SessionTokenMessage
+	(*TokenLoginRequestMessage)(nil),        // 56: packets.TokenLoginRequestMessage
+	(*ChangePasswordRequestMessage)(nil),    // 57: packets.ChangePasswordRequestMessage
+	(*GuestLoginRequestMessage)(nil),        // 58: packets.GuestLoginRequestMessage
+	(*ClaimGuestRequestMessage)(nil),        // 59: packets.ClaimGuestRequestMessage
+	(*LogoutRequestMessage)(nil),            // 60: packets.LogoutRequestMessage
+	(*Packet)(nil),                          // 61: packets.Packet
 }
 var file_packets_proto_depIdxs = []int32{
-	8,  // 0: packets.SporesBatchMessage.spores:type_name -> packets.SporeMessage
-	13, // 1: packets.HiscoreBoardMessage.hiscores:type_name -> packets.HiscoreMessage
-	0,  // 2: packets.Packet.chat:type_name -> packets.ChatMessage
-	1,  // 3: packets.Packet.id:type_name -> packets.IdMessage
-	2,  // 4: packets.Packet.login_request:type_name -> packets.LoginRequestMessage
-	3,  // 5: packets.Packet.register_request:type_name -> packets.RegisterRequestMessage
-	4,  // 6: packets.Packet.ok_response:type_name -> packets.OkResponseMessage
-	5,  // 7: packets.Packet.deny_response:type_name -> packets.DenyResponseMessage
-	6,  // 8: packets.Packet.player:type_name -> packets.PlayerMessage
-	7,  // 9: packets.Packet.player_direction:type_name -> packets.PlayerDirectionMessage
-	8,  // 10: packets.Packet.spore:type_name -> packets.SporeMessage
-	9,  // 11: packets.Packet.spore_consumed:type_name -> packets.SporeConsumedMessage
-	10, // 12: packets.Packet.spores_batch:type_name -> packets.SporesBatchMessage
-	11, // 13: packets.Packet.player_consumed:type_name -> packets.PlayerConsumedMessage
-	12, // 14: packets.Packet.hi_score_board_request:type_name -> packets.HiscoreBoardRequestMessage
-	13, // 15: packets.Packet.hiscore:type_name -> packets.HiscoreMessage
-	14, // 16: packets.Packet.hiscore_board:type_name -> packets.HiscoreBoardMessage
-	15, // 17: packets.Packet.finished_browsing_hiscores:type_name -> packets.FinishedBrowsingHiscoresMessage
-	16, // 18: packets.Packet.search_hiscore:type_name -> packets.SearchHiscoreMessage
-	17, // 19: packets.Packet.disconnect:type_name -> packets.DisconnectMessage
-	18, // 20: packets.Packet.game_bounds:type_name -> packets.GameBoundsMessage
-	21, // [21:21] is the sub-list for method output_type
-	21, // [21:21] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	7,  // 0: packets.PlayerMessage.cells:type_name -> packets.CellMessage
+	8,  // 1: packets.PlayerMessage.power_ups:type_name -> packets.ActivePowerUpMessage
+	10, // 2: packets.SporesBatchMessage.spores:type_name -> packets.SporeMessage
+	15, // 3: packets.HiscoreBoardMessage.hiscores:type_name -> packets.HiscoreMessage
+	6,  // 4: packets.WorldUpdateMessage.players:type_name -> packets.PlayerMessage
+	10, // 5: packets.WorldUpdateMessage.spores:type_name -> packets.SporeMessage
+	23, // 6: packets.RoomListMessage.rooms:type_name -> packets.RoomMessage
+	30, // 7: packets.VirusesBatchMessage.viruses:type_name -> packets.VirusMessage
+	35, // 8: packets.LeaderboardMessage.entries:type_name -> packets.LeaderboardEntryMessage
+	35, // 9: packets.LeaderboardMessage.own:type_name -> packets.LeaderboardEntryMessage
+	35, // 10: packets.RoundEndMessage.winners:type_name -> packets.LeaderboardEntryMessage
+	39, // 11: packets.TeamScoresMessage.scores:type_name -> packets.TeamScoreMessage
+	41, // 12: packets.PowerUpsBatchMessage.power_ups:type_name -> packets.PowerUpMessage
+	0,  // 13: packets.Packet.chat:type_name -> packets.ChatMessage
+	1,  // 14: packets.Packet.id:type_name -> packets.IdMessage
+	2,  // 15: packets.Packet.login_request:type_name -> packets.LoginRequestMessage
+	3,  // 16: packets.Packet.register_request:type_name -> packets.RegisterRequestMessage
+	4,  // 17: packets.Packet.ok_response:type_name -> packets.OkResponseMessage
+	5,  // 18: packets.Packet.deny_response:type_name -> packets.DenyResponseMessage
+	6,  // 19: packets.Packet.player:type_name -> packets.PlayerMessage
+	9,  // 20: packets.Packet.player_direction:type_name -> packets.PlayerDirectionMessage
+	10, // 21: packets.Packet.spore:type_name -> packets.SporeMessage
+	11, // 22: packets.Packet.spore_consumed:type_name -> packets.SporeConsumedMessage
+	12, // 23: packets.Packet.spores_batch:type_name -> packets.SporesBatchMessage
+	13, // 24: packets.Packet.player_consumed:type_name -> packets.PlayerConsumedMessage
+	14, // 25: packets.Packet.hi_score_board_request:type_name -> packets.HiscoreBoardRequestMessage
+	15, // 26: packets.Packet.hiscore:type_name -> packets.HiscoreMessage
+	16, // 27: packets.Packet.hiscore_board:type_name -> packets.HiscoreBoardMessage
+	17, // 28: packets.Packet.finished_browsing_hiscores:type_name -> packets.FinishedBrowsingHiscoresMessage
+	18, // 29: packets.Packet.search_hiscore:type_name -> packets.SearchHiscoreMessage
+	19, // 30: packets.Packet.disconnect:type_name -> packets.DisconnectMessage
+	20, // 31: packets.Packet.game_bounds:type_name -> packets.GameBoundsMessage
+	21, // 32: packets.Packet.world_update:type_name -> packets.WorldUpdateMessage
+	22, // 33: packets.Packet.out_of_view:type_name -> packets.OutOfViewMessage
+	24, // 34: packets.Packet.room_list_request:type_name -> packets.RoomListRequestMessage
+	25, // 35: packets.Packet.room_list:type_name -> packets.RoomListMessage
+	26, // 36: packets.Packet.join_room_request:type_name -> packets.JoinRoomRequestMessage
+	27, // 37: packets.Packet.leave_room_request:type_name -> packets.LeaveRoomRequestMessage
+	28, // 38: packets.Packet.eject_mass:type_name -> packets.EjectMassMessage
+	29, // 39: packets.Packet.split:type_name -> packets.SplitMessage
+	30, // 40: packets.Packet.virus:type_name -> packets.VirusMessage
+	31, // 41: packets.Packet.viruses_batch:type_name -> packets.VirusesBatchMessage
+	32, // 42: packets.Packet.virus_consumed:type_name -> packets.VirusConsumedMessage
+	33, // 43: packets.Packet.spectate_request:type_name -> packets.SpectateRequestMessage
+	34, // 44: packets.Packet.spectate_target:type_name -> packets.SpectateTargetMessage
+	36, // 45: packets.Packet.leaderboard:type_name -> packets.LeaderboardMessage
+	37, // 46: packets.Packet.round_countdown:type_name -> packets.RoundCountdownMessage
+	38, // 47: packets.Packet.round_end:type_name -> packets.RoundEndMessage
+	40, // 48: packets.Packet.team_scores:type_name -> packets.TeamScoresMessage
+	41, // 49: packets.Packet.power_up:type_name -> packets.PowerUpMessage
+	42, // 50: packets.Packet.power_ups_batch:type_name -> packets.PowerUpsBatchMessage
+	43, // 51: packets.Packet.power_up_consumed:type_name -> packets.PowerUpConsumedMessage
+	44, // 52: packets.Packet.create_room_request:type_name -> packets.CreateRoomRequestMessage
+	45, // 53: packets.Packet.room_created:type_name -> packets.RoomCreatedMessage
+	46, // 54: packets.Packet.join_by_code_request:type_name -> packets.JoinByCodeRequestMessage
+	47, // 55: packets.Packet.kick_request:type_name -> packets.KickRequestMessage
+	49, // 56: packets.Packet.close_room_request:type_name -> packets.CloseRoomRequestMessage
+	50, // 57: packets.Packet.set_max_players_request:type_name -> packets.SetMaxPlayersRequestMessage
+	51, // 58: packets.Packet.kicked:type_name -> packets.KickedMessage
+	52, // 59: packets.Packet.room_owner:type_name -> packets.RoomOwnerMessage
+	53, // 60: packets.Packet.resume_token:type_name -> packets.ResumeTokenMessage
+	54, // 61: packets.Packet.resume_request:type_name -> packets.ResumeRequestMessage
+	55, // 62: packets.Packet.session_token:type_name -> packets.SessionTokenMessage
+	56, // 63: packets.Packet.token_login_request:type_name -> packets.TokenLoginRequestMessage
+	60, // 64: packets.Packet.logout_request:type_name -> packets.LogoutRequestMessage
+	57, // 65: packets.Packet.change_password_request:type_name -> packets.ChangePasswordRequestMessage
+	58, // 66: packets.Packet.guest_login_request:type_name -> packets.GuestLoginRequestMessage
+	59, // 67: packets.Packet.claim_guest_request:type_name -> packets.ClaimGuestRequestMessage
+	48, // 68: packets.Packet.mute_request:type_name -> packets.MuteRequestMessage
+	69, // [69:69] is the sub-list for method output_type
+	69, // [69:69] is the sub-list for method input_type
+	69, // [69:69] is the sub-list for extension type_name
+	69, // [69:69] is the sub-list for extension extendee
+	0,  // [0:69] is the sub-list for field type_name
 }
 
 func init() { file_packets_proto_init() }
@@ -1495,7 +4415,7 @@ func file_packets_proto_init() {
 	if File_packets_proto != nil {
 		return
 	}
-	file_packets_proto_msgTypes[19].OneofWrappers = []any{
+	file_packets_proto_msgTypes[61].OneofWrappers = []any{
 		(*Packet_Chat)(nil),
 		(*Packet_Id)(nil),
 		(*Packet_LoginRequest)(nil),
@@ -1515,6 +4435,43 @@ func file_packets_proto_init() {
 		(*Packet_SearchHiscore)(nil),
 		(*Packet_Disconnect)(nil),
 		(*Packet_GameBounds)(nil),
+		(*Packet_WorldUpdate)(nil),
+		(*Packet_OutOfView)(nil),
+		(*Packet_RoomListRequest)(nil),
+		(*Packet_RoomList)(nil),
+		(*Packet_JoinRoomRequest)(nil),
+		(*Packet_LeaveRoomRequest)(nil),
+		(*Packet_EjectMass)(nil),
+		(*Packet_Split)(nil),
+		(*Packet_Virus)(nil),
+		(*Packet_VirusesBatch)(nil),
+		(*Packet_VirusConsumed)(nil),
+		(*Packet_SpectateRequest)(nil),
+		(*Packet_SpectateTarget)(nil),
+		(*Packet_Leaderboard)(nil),
+		(*Packet_RoundCountdown)(nil),
+		(*Packet_RoundEnd)(nil),
+		(*Packet_TeamScores)(nil),
+		(*Packet_PowerUp)(nil),
+		(*Packet_PowerUpsBatch)(nil),
+		(*Packet_PowerUpConsumed)(nil),
+		(*Packet_CreateRoomRequest)(nil),
+		(*Packet_RoomCreated)(nil),
+		(*Packet_JoinByCodeRequest)(nil),
+		(*Packet_KickRequest)(nil),
+		(*Packet_CloseRoomRequest)(nil),
+		(*Packet_SetMaxPlayersRequest)(nil),
+		(*Packet_Kicked)(nil),
+		(*Packet_RoomOwner)(nil),
+		(*Packet_ResumeToken)(nil),
+		(*Packet_ResumeRequest)(nil),
+		(*Packet_SessionToken)(nil),
+		(*Packet_TokenLoginRequest)(nil),
+		(*Packet_LogoutRequest)(nil),
+		(*Packet_ChangePasswordRequest)(nil),
+		(*Packet_GuestLoginRequest)(nil),
+		(*Packet_ClaimGuestRequest)(nil),
+		(*Packet_MuteRequest)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1522,7 +4479,7 @@ func file_packets_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_packets_proto_rawDesc), len(file_packets_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   62,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
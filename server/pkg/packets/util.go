@@ -1,6 +1,12 @@
 package packets
 
-import "server/internal/server/objects"
+import (
+	"maps"
+	"slices"
+	"time"
+
+	"server/internal/server/objects"
+)
 
 type Msg = isPacket_Msg
 
@@ -34,18 +40,49 @@ func NewOkResponse() Msg {
 	}
 }
 
+func newPlayerMessage(id uint64, player *objects.Player) *PlayerMessage {
+	return &PlayerMessage{
+		Id:        id,
+		Name:      player.Name,
+		X:         player.X,
+		Y:         player.Y,
+		Radius:    player.Radius,
+		Direction: player.Direction,
+		Speed:     player.CurrentSpeed(),
+		Color:     player.DisplayColor(),
+		Cells:     newCellMessages(player.Cells),
+		Team:      uint32(player.Team),
+		PowerUps:  newActivePowerUpMessages(player.PowerUps),
+	}
+}
+
+// Ordered by kind so the same player always produces the same message
+func newActivePowerUpMessages(powerUps map[objects.PowerUpKind]time.Time) []*ActivePowerUpMessage {
+	messages := make([]*ActivePowerUpMessage, 0, len(powerUps))
+	for _, kind := range slices.Sorted(maps.Keys(powerUps)) {
+		messages = append(messages, &ActivePowerUpMessage{
+			Kind:        uint32(kind),
+			SecondsLeft: max(time.Until(powerUps[kind]).Seconds(), 0),
+		})
+	}
+	return messages
+}
+
+func newCellMessages(cells []*objects.Cell) []*CellMessage {
+	cellMessages := make([]*CellMessage, 0, len(cells))
+	for _, cell := range cells {
+		cellMessages = append(cellMessages, &CellMessage{
+			X:      cell.X,
+			Y:      cell.Y,
+			Radius: cell.Radius,
+		})
+	}
+	return cellMessages
+}
+
 func NewPlayer(id uint64, player *objects.Player) Msg {
 	return &Packet_Player{
-		Player: &PlayerMessage{
-			Id:        id,
-			Name:      player.Name,
-			X:         player.X,
-			Y:         player.Y,
-			Radius:    player.Radius,
-			Direction: player.Direction,
-			Speed:     player.Speed,
-			Color:     player.Color,
-		},
+		Player: newPlayerMessage(id, player),
 	}
 }
 
@@ -76,6 +113,77 @@ func NewSporeBatch(spores map[uint64]*objects.Spore) Msg {
 	}
 }
 
+func newVirusMessage(id uint64, virus *objects.Virus) *VirusMessage {
+	return &VirusMessage{
+		Id:     id,
+		X:      virus.X,
+		Y:      virus.Y,
+		Radius: virus.Radius,
+	}
+}
+
+func NewVirus(id uint64, virus *objects.Virus) Msg {
+	return &Packet_Virus{
+		Virus: newVirusMessage(id, virus),
+	}
+}
+
+func NewVirusesBatch(viruses map[uint64]*objects.Virus) Msg {
+	virusMessages := make([]*VirusMessage, 0, len(viruses))
+	for id, virus := range viruses {
+		virusMessages = append(virusMessages, newVirusMessage(id, virus))
+	}
+	return &Packet_VirusesBatch{
+		VirusesBatch: &VirusesBatchMessage{
+			Viruses: virusMessages,
+		},
+	}
+}
+
+func NewVirusConsumed(virusId uint64) Msg {
+	return &Packet_VirusConsumed{
+		VirusConsumed: &VirusConsumedMessage{
+			VirusId: virusId,
+		},
+	}
+}
+
+func newPowerUpMessage(id uint64, powerUp *objects.PowerUp) *PowerUpMessage {
+	return &PowerUpMessage{
+		Id:     id,
+		X:      powerUp.X,
+		Y:      powerUp.Y,
+		Radius: powerUp.Radius,
+		Kind:   uint32(powerUp.Kind),
+	}
+}
+
+func NewPowerUp(id uint64, powerUp *objects.PowerUp) Msg {
+	return &Packet_PowerUp{
+		PowerUp: newPowerUpMessage(id, powerUp),
+	}
+}
+
+func NewPowerUpsBatch(powerUps map[uint64]*objects.PowerUp) Msg {
+	powerUpMessages := make([]*PowerUpMessage, 0, len(powerUps))
+	for id, powerUp := range powerUps {
+		powerUpMessages = append(powerUpMessages, newPowerUpMessage(id, powerUp))
+	}
+	return &Packet_PowerUpsBatch{
+		PowerUpsBatch: &PowerUpsBatchMessage{
+			PowerUps: powerUpMessages,
+		},
+	}
+}
+
+func NewPowerUpConsumed(powerUpId uint64) Msg {
+	return &Packet_PowerUpConsumed{
+		PowerUpConsumed: &PowerUpConsumedMessage{
+			PowerUpId: powerUpId,
+		},
+	}
+}
+
 func NewHiscoreBoard(hiscores []*HiscoreMessage) Msg {
 	return &Packet_HiscoreBoard{
 		HiscoreBoard: &HiscoreBoardMessage{
@@ -102,3 +210,162 @@ func NewGameBounds(minX, maxX, minY, maxY float64) Msg {
 		},
 	}
 }
+
+// Players and spores are ordered by ID so the same world state always produces the same update
+func NewWorldUpdate(players map[uint64]*objects.Player, movingSpores map[uint64]*objects.Spore) Msg {
+	playerMessages := make([]*PlayerMessage, 0, len(players))
+	for _, id := range slices.Sorted(maps.Keys(players)) {
+		playerMessages = append(playerMessages, newPlayerMessage(id, players[id]))
+	}
+	sporeMessages := make([]*SporeMessage, 0, len(movingSpores))
+	for _, id := range slices.Sorted(maps.Keys(movingSpores)) {
+		sporeMessages = append(sporeMessages, newSporeMessage(id, movingSpores[id]))
+	}
+	return &Packet_WorldUpdate{
+		WorldUpdate: &WorldUpdateMessage{
+			Players: playerMessages,
+			Spores:  sporeMessages,
+		},
+	}
+}
+
+func NewOutOfView(playerIds []uint64, sporeIds []uint64, virusIds []uint64, powerUpIds []uint64) Msg {
+	return &Packet_OutOfView{
+		OutOfView: &OutOfViewMessage{
+			PlayerIds:  playerIds,
+			SporeIds:   sporeIds,
+			VirusIds:   virusIds,
+			PowerUpIds: powerUpIds,
+		},
+	}
+}
+
+// Copies a world update, keeping only the players and spores for which keepPlayer and keepSpore
+// return true
+func FilterWorldUpdate(update *WorldUpdateMessage, keepPlayer func(*PlayerMessage) bool, keepSpore func(*SporeMessage) bool) Msg {
+	playerMessages := make([]*PlayerMessage, 0, len(update.Players))
+	for _, playerMessage := range update.Players {
+		if keepPlayer(playerMessage) {
+			playerMessages = append(playerMessages, playerMessage)
+		}
+	}
+	sporeMessages := make([]*SporeMessage, 0, len(update.Spores))
+	for _, sporeMessage := range update.Spores {
+		if keepSpore(sporeMessage) {
+			sporeMessages = append(sporeMessages, sporeMessage)
+		}
+	}
+	return &Packet_WorldUpdate{
+		WorldUpdate: &WorldUpdateMessage{
+			Players: playerMessages,
+			Spores:  sporeMessages,
+		},
+	}
+}
+
+func NewSporeConsumed(sporeId uint64) Msg {
+	return &Packet_SporeConsumed{
+		SporeConsumed: &SporeConsumedMessage{
+			SporeId: sporeId,
+		},
+	}
+}
+
+func NewPlayerConsumed(playerId uint64) Msg {
+	return &Packet_PlayerConsumed{
+		PlayerConsumed: &PlayerConsumedMessage{
+			PlayerId: playerId,
+		},
+	}
+}
+
+func NewRoomList(rooms []*RoomMessage) Msg {
+	return &Packet_RoomList{
+		RoomList: &RoomListMessage{
+			Rooms: rooms,
+		},
+	}
+}
+
+func NewSpectateTarget(playerId uint64) Msg {
+	return &Packet_SpectateTarget{
+		SpectateTarget: &SpectateTargetMessage{
+			PlayerId: playerId,
+		},
+	}
+}
+
+func NewLeaderboard(entries []*LeaderboardEntryMessage, own *LeaderboardEntryMessage) Msg {
+	return &Packet_Leaderboard{
+		Leaderboard: &LeaderboardMessage{
+			Entries: entries,
+			Own:     own,
+		},
+	}
+}
+
+func NewRoundCountdown(secondsLeft uint32) Msg {
+	return &Packet_RoundCountdown{
+		RoundCountdown: &RoundCountdownMessage{
+			SecondsLeft: secondsLeft,
+		},
+	}
+}
+
+func NewRoundEnd(winners []*LeaderboardEntryMessage) Msg {
+	return &Packet_RoundEnd{
+		RoundEnd: &RoundEndMessage{
+			Winners: winners,
+		},
+	}
+}
+
+func NewTeamScores(scores []*TeamScoreMessage) Msg {
+	return &Packet_TeamScores{
+		TeamScores: &TeamScoresMessage{
+			Scores: scores,
+		},
+	}
+}
+
+func NewRoomCreated(roomId uint64, code string) Msg {
+	return &Packet_RoomCreated{
+		RoomCreated: &RoomCreatedMessage{
+			RoomId: roomId,
+			Code:   code,
+		},
+	}
+}
+
+func NewKicked(reason string) Msg {
+	return &Packet_Kicked{
+		Kicked: &KickedMessage{
+			Reason: reason,
+		},
+	}
+}
+
+func NewRoomOwner(playerId uint64) Msg {
+	return &Packet_RoomOwner{
+		RoomOwner: &RoomOwnerMessage{
+			PlayerId: playerId,
+		},
+	}
+}
+
+func NewResumeToken(token string) Msg {
+	return &Packet_ResumeToken{
+		ResumeToken: &ResumeTokenMessage{
+			Token: token,
+		},
+	}
+}
+
+func NewSessionToken(token string, expiresAt int64) Msg {
+	return &Packet_SessionToken{
+		SessionToken: &SessionTokenMessage{
+			Token:     token,
+			ExpiresAt: expiresAt,
+		},
+	}
+}